@@ -0,0 +1,30 @@
+package null
+
+import (
+	"bytes"
+	"testing"
+)
+
+var (
+	nullJSON       = []byte(`null`)
+	invalidJSON    = []byte(`:`)
+	boolJSON       = []byte(`true`)
+	floatJSON      = []byte(`1.2345`)
+	floatBlankJSON = []byte(`""`)
+)
+
+// maybePanic panics if err is non-nil. It's a shorthand for test setup steps
+// that aren't expected to fail.
+func maybePanic(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// assertJSONEquals fails the test if data doesn't exactly match expected.
+func assertJSONEquals(t *testing.T, data []byte, expected string, from string) {
+	t.Helper()
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf("bad %s data: %s ≠ %s\n", from, data, expected)
+	}
+}
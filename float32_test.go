@@ -0,0 +1,265 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var (
+	float32JSON       = []byte(`1.2345`)
+	float32StringJSON = []byte(`"1.2345"`)
+)
+
+func TestFloat32From(t *testing.T) {
+	f := Float32From(1.2345)
+	assertFloat32(t, f, "Float32From()")
+
+	zero := Float32From(0)
+	if !zero.Valid {
+		t.Error("Float32From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestFloat32FromPtr(t *testing.T) {
+	n := float32(1.2345)
+	fptr := &n
+	f := Float32FromPtr(fptr)
+	assertFloat32(t, f, "Float32FromPtr()")
+
+	null := Float32FromPtr(nil)
+	assertNullFloat32(t, null, "Float32FromPtr(nil)")
+}
+
+func TestUnmarshalFloat32(t *testing.T) {
+	var f Float32
+	err := json.Unmarshal(float32JSON, &f)
+	maybePanic(err)
+	assertFloat32(t, f, "float32 json")
+
+	var sf Float32
+	err = json.Unmarshal(float32StringJSON, &sf)
+	maybePanic(err)
+	assertFloat32(t, sf, "float32 string json")
+
+	var null Float32
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullFloat32(t, null, "null json")
+
+	var badType Float32
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullFloat32(t, badType, "wrong type json")
+
+	var invalid Float32
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullFloat32(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalFloat32(t *testing.T) {
+	var f Float32
+	err := f.UnmarshalText([]byte("1.2345"))
+	maybePanic(err)
+	assertFloat32(t, f, "UnmarshalText() float32")
+
+	var blank Float32
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullFloat32(t, blank, "UnmarshalText() empty float32")
+
+	var null Float32
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullFloat32(t, null, `UnmarshalText() "null"`)
+
+	var invalid Float32
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalFloat32(t *testing.T) {
+	f := Float32From(1.2345)
+	data, err := json.Marshal(f)
+	maybePanic(err)
+	assertJSONEquals(t, data, "1.2345", "non-empty json marshal")
+
+	null := NewFloat32(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalFloat32Text(t *testing.T) {
+	f := Float32From(1.2345)
+	data, err := f.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "1.2345", "non-empty text marshal")
+
+	null := NewFloat32(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestFloat32Pointer(t *testing.T) {
+	f := Float32From(1.2345)
+	ptr := f.Ptr()
+	if *ptr != 1.2345 {
+		t.Errorf("bad %s float32: %#v ≠ %v\n", "pointer", ptr, 1.2345)
+	}
+
+	null := NewFloat32(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s float32: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestFloat32IsZero(t *testing.T) {
+	f := Float32From(1.2345)
+	if f.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewFloat32(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewFloat32(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestFloat32SetValid(t *testing.T) {
+	change := NewFloat32(0, false)
+	assertNullFloat32(t, change, "SetValid()")
+	change.SetValid(1.2345)
+	assertFloat32(t, change, "SetValid()")
+}
+
+func TestFloat32Scan(t *testing.T) {
+	var f Float32
+	err := f.Scan(1.2345)
+	maybePanic(err)
+	assertFloat32(t, f, "scanned float32")
+
+	var null Float32
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullFloat32(t, null, "scanned null")
+}
+
+func TestFloat32Value(t *testing.T) {
+	v, err := Float32From(1.2345).Value()
+	maybePanic(err)
+	if v != float64(float32(1.2345)) {
+		t.Errorf("bad Value(): %#v ≠ %v", v, float64(float32(1.2345)))
+	}
+
+	v, err = NewFloat32(0, false).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad Value(): %#v is not nil", v)
+	}
+}
+
+func TestFloat32ValueOrZero(t *testing.T) {
+	valid := NewFloat32(1.2345, true)
+	if valid.ValueOrZero() != 1.2345 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewFloat32(1.2345, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestFloat32Equal(t *testing.T) {
+	float1 := NewFloat32(10, false)
+	float2 := NewFloat32(10, false)
+	assertFloat32EqualIsTrue(t, float1, float2)
+
+	float1 = NewFloat32(10, false)
+	float2 = NewFloat32(20, false)
+	assertFloat32EqualIsTrue(t, float1, float2)
+
+	float1 = NewFloat32(10, true)
+	float2 = NewFloat32(10, true)
+	assertFloat32EqualIsTrue(t, float1, float2)
+
+	float1 = NewFloat32(10, true)
+	float2 = NewFloat32(10, false)
+	assertFloat32EqualIsFalse(t, float1, float2)
+
+	float1 = NewFloat32(10, false)
+	float2 = NewFloat32(10, true)
+	assertFloat32EqualIsFalse(t, float1, float2)
+
+	float1 = NewFloat32(10, true)
+	float2 = NewFloat32(20, true)
+	assertFloat32EqualIsFalse(t, float1, float2)
+}
+
+func assertFloat32(t *testing.T, f Float32, from string) {
+	if f.ValueOrZero() != 1.2345 {
+		t.Errorf("bad %s float32: %v ≠ %v\n", from, f.ValueOrZero(), 1.2345)
+	}
+	if !f.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullFloat32(t *testing.T, f Float32, from string) {
+	if f.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertFloat32EqualIsTrue(t *testing.T, a, b Float32) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Float32{%v, Valid:%t} and Float32{%v, Valid:%t} should return true", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func assertFloat32EqualIsFalse(t *testing.T, a, b Float32) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Float32{%v, Valid:%t} and Float32{%v, Valid:%t} should return false", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func TestFloat32UnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader(bytes.Join([][]byte{float32JSON, float32StringJSON, nullJSON}, []byte(" "))))
+
+	var a Float32
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertFloat32(t, a, "UnmarshalNext() number")
+
+	var b Float32
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertFloat32(t, b, "UnmarshalNext() string")
+
+	var c Float32
+	if err := c.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertNullFloat32(t, c, "UnmarshalNext() null")
+}
@@ -0,0 +1,167 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/toby1991/null/nulljson"
+)
+
+// Float64 is a nullable float64, implemented as a thin wrapper over
+// Variable[float64].
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+//
+// MarshalJSON, MarshalText, UnmarshalJSON, UnmarshalText, and Scan are
+// overridden here: the first two always format in 'f' notation rather than
+// Variable's shortest-round-trip encoding, which can use scientific
+// notation, and the rest accept the string input and int64 Scan source this
+// type has always accepted. ValueOrZero, SetValid, IsZero, and Value are
+// inherited from Variable[float64].
+type Float64 struct {
+	Variable[float64]
+}
+
+// NewFloat64 creates a new Float64
+func NewFloat64(f float64, valid bool) Float64 {
+	return Float64{Variable: NewVariable(f, valid)}
+}
+
+// Float64From creates a new Float64 that will always be valid.
+func Float64From(f float64) Float64 {
+	return NewFloat64(f, true)
+}
+
+// Float64FromPtr creates a new Float64 that be null if f is nil.
+func Float64FromPtr(f *float64) Float64 {
+	if f == nil {
+		return NewFloat64(0, false)
+	}
+	return NewFloat64(*f, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Float64.
+func (f *Float64) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		f.Valid = false
+		return nil
+	}
+
+	var _n float64
+	if err := json.Unmarshal(data, &_n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" {
+				return fmt.Errorf("null: JSON input is invalid type (need float or string): %w", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseFloat(str, 64)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to float: %w", err)
+			}
+			f.val = float64(n)
+			f.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+
+	f.val = float64(_n)
+	f.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Float64 if the input is blank.
+// It will return an error if the input is not a float, blank, or "null".
+func (f *Float64) UnmarshalText(text []byte) error {
+	str := string(text)
+	if str == "" || str == "null" {
+		f.Valid = false
+		return nil
+	}
+	n, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return fmt.Errorf("null: couldn't convert string to float: %w", err)
+	}
+	f.val = float64(n)
+	f.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Float64 is null.
+func (f Float64) MarshalJSON() ([]byte, error) {
+	if !f.Valid {
+		return []byte("null"), nil
+	}
+	return strconv.AppendFloat([]byte{}, float64(f.val), 'f', -1, 64), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Float64 is null.
+func (f Float64) MarshalText() ([]byte, error) {
+	if !f.Valid {
+		return []byte{}, nil
+	}
+	return strconv.AppendFloat([]byte{}, float64(f.val), 'f', -1, 64), nil
+}
+
+// Ptr returns a pointer to this Float64's value, or a nil pointer if this Float64 is null.
+func (f Float64) Ptr() *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.val
+}
+
+// Equal returns true if both floats have the same value or are both null.
+func (f Float64) Equal(other Float64) bool {
+	return f.Valid == other.Valid && (!f.Valid || f.val == other.val)
+}
+
+// Scan implements the sql.Scanner interface.
+func (f *Float64) Scan(value interface{}) error {
+	if value == nil {
+		f.val, f.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case float64:
+		f.val = float64(v)
+	case []byte:
+		n, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan []byte into float64: %w", err)
+		}
+		f.val = float64(n)
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan string into float64: %w", err)
+		}
+		f.val = float64(n)
+	case int64:
+		f.val = float64(v)
+	default:
+		return fmt.Errorf("null: cannot scan type %T into null.Float64: %v", value, value)
+	}
+	f.Valid = true
+	return nil
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (f *Float64) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, f)
+}
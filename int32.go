@@ -0,0 +1,133 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/toby1991/null/nulljson"
+)
+
+// Int32 is a nullable int32, implemented as a thin wrapper over
+// Variable[int32].
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+//
+// UnmarshalJSON and Scan are overridden here for int32-specific overflow
+// checking and string-accepting input that Variable has no way to express
+// generically. ValueOrZero, SetValid, IsZero, MarshalText, UnmarshalText,
+// and Value are inherited from Variable[int32].
+type Int32 struct {
+	Variable[int32]
+}
+
+// NewInt32 creates a new Int32
+func NewInt32(i int32, valid bool) Int32 {
+	return Int32{Variable: NewVariable(i, valid)}
+}
+
+// Int32From creates a new Int32 that will always be valid.
+func Int32From(i int32) Int32 {
+	return NewInt32(i, true)
+}
+
+// Int32FromPtr creates a new Int32 that be null if i is nil.
+func Int32FromPtr(i *int32) Int32 {
+	if i == nil {
+		return NewInt32(0, false)
+	}
+	return NewInt32(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Int32.
+func (i *Int32) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	var _n int64
+	if err := json.Unmarshal(data, &_n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" {
+				return fmt.Errorf("null: JSON input is invalid type (need int or string): %w", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseInt(str, 10, 32)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to int32: %w", err)
+			}
+			i.val = int32(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if _n < math.MinInt32 || _n > math.MaxInt32 {
+		return fmt.Errorf("null: %d overflows int32", _n)
+	}
+	i.val = int32(_n)
+	i.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this Int32's value, or a nil pointer if this Int32 is null.
+func (i Int32) Ptr() *int32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.val
+}
+
+// Equal returns true if both int32s have the same value or are both null.
+func (i Int32) Equal(other Int32) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.val == other.val)
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Int32) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < math.MinInt32 || v > math.MaxInt32 {
+			return fmt.Errorf("null: %d overflows int32", v)
+		}
+		i.val = int32(v)
+	case []byte:
+		n, err := strconv.ParseInt(string(v), 10, 32)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan []byte into int32: %w", err)
+		}
+		i.val = int32(n)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan string into int32: %w", err)
+		}
+		i.val = int32(n)
+	default:
+		return fmt.Errorf("null: cannot scan type %T into null.Int32: %v", value, value)
+	}
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Int32) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
+}
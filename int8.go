@@ -0,0 +1,133 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/toby1991/null/nulljson"
+)
+
+// Int8 is a nullable int8, implemented as a thin wrapper over
+// Variable[int8].
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+//
+// UnmarshalJSON and Scan are overridden here for int8-specific overflow
+// checking and string-accepting input that Variable has no way to express
+// generically. ValueOrZero, SetValid, IsZero, MarshalText, UnmarshalText,
+// and Value are inherited from Variable[int8].
+type Int8 struct {
+	Variable[int8]
+}
+
+// NewInt8 creates a new Int8
+func NewInt8(i int8, valid bool) Int8 {
+	return Int8{Variable: NewVariable(i, valid)}
+}
+
+// Int8From creates a new Int8 that will always be valid.
+func Int8From(i int8) Int8 {
+	return NewInt8(i, true)
+}
+
+// Int8FromPtr creates a new Int8 that be null if i is nil.
+func Int8FromPtr(i *int8) Int8 {
+	if i == nil {
+		return NewInt8(0, false)
+	}
+	return NewInt8(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Int8.
+func (i *Int8) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	var _n int64
+	if err := json.Unmarshal(data, &_n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" {
+				return fmt.Errorf("null: JSON input is invalid type (need int or string): %w", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseInt(str, 10, 8)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to int8: %w", err)
+			}
+			i.val = int8(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if _n < math.MinInt8 || _n > math.MaxInt8 {
+		return fmt.Errorf("null: %d overflows int8", _n)
+	}
+	i.val = int8(_n)
+	i.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this Int8's value, or a nil pointer if this Int8 is null.
+func (i Int8) Ptr() *int8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.val
+}
+
+// Equal returns true if both int8s have the same value or are both null.
+func (i Int8) Equal(other Int8) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.val == other.val)
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Int8) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < math.MinInt8 || v > math.MaxInt8 {
+			return fmt.Errorf("null: %d overflows int8", v)
+		}
+		i.val = int8(v)
+	case []byte:
+		n, err := strconv.ParseInt(string(v), 10, 8)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan []byte into int8: %w", err)
+		}
+		i.val = int8(n)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 8)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan string into int8: %w", err)
+		}
+		i.val = int8(n)
+	default:
+		return fmt.Errorf("null: cannot scan type %T into null.Int8: %v", value, value)
+	}
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Int8) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
+}
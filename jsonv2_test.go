@@ -0,0 +1,24 @@
+//go:build goexperiment.jsonv2
+
+package null
+
+import (
+	jsonv2 "encoding/json/v2"
+	"testing"
+)
+
+type omitzeroStruct struct {
+	X Uint `json:",omitzero"`
+}
+
+func TestOmitzeroOmitsNullField(t *testing.T) {
+	data, err := jsonv2.Marshal(omitzeroStruct{X: NewUint(0, false)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{}`, "omitzero null field")
+}
+
+func TestOmitzeroKeepsSetField(t *testing.T) {
+	data, err := jsonv2.Marshal(omitzeroStruct{X: UintFrom(123)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"X":123}`, "omitzero set field")
+}
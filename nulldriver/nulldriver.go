@@ -0,0 +1,30 @@
+// Package nulldriver provides a shared helper that Variable[T] in the null
+// and zero packages uses to implement driver.Valuer for an arbitrary T.
+package nulldriver
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strconv"
+)
+
+// Value converts v into one of the types driver.Value allows
+// (int64, float64, bool, []byte, string, time.Time, nil), the same way
+// database/sql/driver.DefaultParameterConverter would -- so a type like
+// time.Duration, whose underlying kind is int64, converts correctly instead
+// of being passed through as-is.
+//
+// DefaultParameterConverter refuses a uint64 with the high bit set, since
+// driver.Value has no unsigned 64-bit type; that case is formatted as a
+// decimal string instead. Uint gets its Value method from Variable, so a Uint
+// above math.MaxInt64 comes back as such a string.
+func Value(v interface{}) (driver.Value, error) {
+	dv, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err == nil {
+		return dv, nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Uint64 {
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	return nil, err
+}
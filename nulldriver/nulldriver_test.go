@@ -0,0 +1,48 @@
+package nulldriver
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestValueConvertsUnderlyingKind(t *testing.T) {
+	v, err := Value(5 * time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != int64(5*time.Second) {
+		t.Errorf("got %#v, want %#v", v, int64(5*time.Second))
+	}
+}
+
+func TestValuePassesThroughAllowedTypes(t *testing.T) {
+	v, err := Value("hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "hello" {
+		t.Errorf("got %#v, want %q", v, "hello")
+	}
+}
+
+func TestValueWideUint64(t *testing.T) {
+	v, err := Value(uint64(math.MaxUint64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "18446744073709551615"
+	if v != want {
+		t.Errorf("got %#v, want %q", v, want)
+	}
+}
+
+func TestValueNarrowUint64(t *testing.T) {
+	v, err := Value(uint64(12345))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != int64(12345) {
+		t.Errorf("got %#v, want %#v", v, int64(12345))
+	}
+}
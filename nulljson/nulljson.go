@@ -0,0 +1,65 @@
+// Package nulljson provides a shared helper that the nullable types in the
+// null and zero packages use to implement UnmarshalNext, letting callers
+// decode them straight off a json.Decoder stream instead of having to slice
+// each value's raw bytes out themselves first (e.g. with bufio.Scanner and a
+// custom SplitFunc).
+package nulljson
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Unmarshaler is the subset of json.Unmarshaler that UnmarshalNext drives.
+// Every nullable type in this module already implements it.
+type Unmarshaler interface {
+	UnmarshalJSON([]byte) error
+}
+
+var nullBytes = []byte("null")
+
+// UnmarshalNext reads one scalar JSON value (number, string, bool, or null)
+// from dec and feeds it to v's UnmarshalJSON, the same way json.Unmarshal
+// would after reading that value out of a buffer. Objects and arrays are not
+// supported: their opening delimiter is consumed and an error is returned.
+// It's meant to be called from a type's own UnmarshalNext method:
+//
+//	func (i *Uint) UnmarshalNext(dec *json.Decoder) error {
+//		return nulljson.UnmarshalNext(dec, i)
+//	}
+//
+// A JSON null is recognized directly off the token stream and passed on as
+// the shared null bytes, without re-encoding; json.Decoder.Token() still
+// allocates while reading it. Numbers and strings are re-encoded into the
+// handful of bytes UnmarshalJSON expects. This is not necessarily fewer allocations than
+// slicing a record's bytes out and calling UnmarshalJSON directly --
+// json.Decoder.Token() has its own per-token cost -- but it means the
+// caller never has to work out where one value ends and the next begins.
+func UnmarshalNext(dec *json.Decoder, v Unmarshaler) error {
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("nulljson: couldn't read token: %w", err)
+	}
+
+	switch t := tok.(type) {
+	case nil:
+		return v.UnmarshalJSON(nullBytes)
+	case json.Number:
+		return v.UnmarshalJSON([]byte(t.String()))
+	case string:
+		data, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Errorf("nulljson: couldn't re-encode string token: %w", err)
+		}
+		return v.UnmarshalJSON(data)
+	case bool:
+		if t {
+			return v.UnmarshalJSON([]byte("true"))
+		}
+		return v.UnmarshalJSON([]byte("false"))
+	default:
+		return fmt.Errorf("nulljson: unexpected token %T in stream", tok)
+	}
+}
@@ -0,0 +1,74 @@
+package nulljson
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+type fakeUnmarshaler struct {
+	got string
+}
+
+func (f *fakeUnmarshaler) UnmarshalJSON(data []byte) error {
+	f.got = string(data)
+	return nil
+}
+
+func TestUnmarshalNextNumber(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`12345`))
+	var f fakeUnmarshaler
+	if err := UnmarshalNext(dec, &f); err != nil {
+		t.Fatal(err)
+	}
+	if f.got != "12345" {
+		t.Errorf("got %q, want %q", f.got, "12345")
+	}
+}
+
+func TestUnmarshalNextString(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`"12345"`))
+	var f fakeUnmarshaler
+	if err := UnmarshalNext(dec, &f); err != nil {
+		t.Fatal(err)
+	}
+	if f.got != `"12345"` {
+		t.Errorf("got %q, want %q", f.got, `"12345"`)
+	}
+}
+
+func TestUnmarshalNextNull(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`null`))
+	var f fakeUnmarshaler
+	if err := UnmarshalNext(dec, &f); err != nil {
+		t.Fatal(err)
+	}
+	if f.got != "null" {
+		t.Errorf("got %q, want %q", f.got, "null")
+	}
+}
+
+func TestUnmarshalNextBool(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`true`))
+	var f fakeUnmarshaler
+	if err := UnmarshalNext(dec, &f); err != nil {
+		t.Fatal(err)
+	}
+	if f.got != "true" {
+		t.Errorf("got %q, want %q", f.got, "true")
+	}
+}
+
+func TestUnmarshalNextStream(t *testing.T) {
+	dec := json.NewDecoder(strings.NewReader(`12345 "67890" null`))
+	var want = []string{"12345", `"67890"`, "null"}
+	for _, w := range want {
+		var f fakeUnmarshaler
+		if err := UnmarshalNext(dec, &f); err != nil {
+			t.Fatal(err)
+		}
+		if f.got != w {
+			t.Errorf("got %q, want %q", f.got, w)
+		}
+	}
+}
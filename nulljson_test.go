@@ -0,0 +1,106 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestUintUnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(`12345 "67890" null`)))
+
+	var a Uint
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertUint(t, a, "UnmarshalNext() number")
+
+	var b Uint
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	if b.ValueOrZero() != 67890 {
+		t.Errorf("bad UnmarshalNext() string: %d ≠ %d", b.ValueOrZero(), 67890)
+	}
+
+	var c Uint
+	if err := c.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertNullUint(t, c, "UnmarshalNext() null")
+}
+
+// streamValues builds the kind of input a caller decoding an NDJSON stream
+// of records would see: many whitespace-separated numbers.
+func streamValues(n int) []byte {
+	var buf bytes.Buffer
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString("12345")
+	}
+	return buf.Bytes()
+}
+
+const benchStreamSize = 1000
+
+// BenchmarkUintUnmarshalJSON simulates the buffered path: each record's raw
+// bytes already split out (e.g. by bufio.Scanner) before being unmarshaled.
+func BenchmarkUintUnmarshalJSON(b *testing.B) {
+	raw := []byte(`12345`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < benchStreamSize; j++ {
+			var u Uint
+			if err := json.Unmarshal(raw, &u); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkUintUnmarshalNext decodes the same number of values directly off
+// a single json.Decoder wrapping the whole stream, instead of needing each
+// record's bytes sliced out by the caller first.
+//
+// Contrary to what an earlier version of this comment claimed, this is not
+// fewer allocations than BenchmarkUintUnmarshalJSON above: see
+// BenchmarkUintUnmarshalNextTokenOnly, which isolates json.Decoder.Token()
+// itself and accounts for most of the difference. UnmarshalNext's
+// value isn't raw throughput over already-isolated bytes -- it's not
+// needing a delimiter-based splitter (bufio.Scanner and a custom SplitFunc)
+// at all when the input is a stream of back-to-back JSON values that
+// Decoder already knows how to walk.
+func BenchmarkUintUnmarshalNext(b *testing.B) {
+	data := streamValues(benchStreamSize)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		for j := 0; j < benchStreamSize; j++ {
+			var u Uint
+			if err := u.UnmarshalNext(dec); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkUintUnmarshalNextTokenOnly isolates the cost of reading tokens off
+// a json.Decoder, with no UnmarshalJSON call at all, to show that
+// json.Decoder.Token() itself -- not the re-encode/decode round trip inside
+// UnmarshalNext -- accounts for most of the gap between the two benchmarks
+// above.
+func BenchmarkUintUnmarshalNextTokenOnly(b *testing.B) {
+	data := streamValues(benchStreamSize)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		for j := 0; j < benchStreamSize; j++ {
+			if _, err := dec.Token(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+//go:build goexperiment.jsonv2
+
+// Package nulljsonv2 bridges the nullable types in the null and zero
+// packages to Go 1.24's encoding/json/v2 and jsontext APIs. Implementing
+// MarshalJSONTo/UnmarshalJSONFrom lets json/v2 call a type's existing
+// IsZero method to decide whether to omit a field entirely for the
+// `omitzero` struct tag, without json/v2 having to reflect into the type to
+// find a Valid field itself.
+package nulljsonv2
+
+import (
+	"encoding/json/jsontext"
+)
+
+// JSONMarshaler is the subset of json.Marshaler every nullable type in this
+// module already implements.
+type JSONMarshaler interface {
+	MarshalJSON() ([]byte, error)
+}
+
+// JSONUnmarshaler is the subset of json.Unmarshaler every nullable type in
+// this module already implements.
+type JSONUnmarshaler interface {
+	UnmarshalJSON([]byte) error
+}
+
+// MarshalJSONTo writes v's existing MarshalJSON encoding to enc, so a type
+// can implement json/v2's MarshalerTo without duplicating its encoding
+// logic. It's meant to be called from a type's own MarshalJSONTo method:
+//
+//	func (i Uint) MarshalJSONTo(enc *jsontext.Encoder) error {
+//		return nulljsonv2.MarshalJSONTo(enc, i)
+//	}
+func MarshalJSONTo(enc *jsontext.Encoder, v JSONMarshaler) error {
+	data, err := v.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(data)
+}
+
+// UnmarshalJSONFrom reads exactly one JSON value from dec and feeds it to
+// v's existing UnmarshalJSON, so a type can implement json/v2's
+// UnmarshalerFrom without duplicating its decoding logic:
+//
+//	func (i *Uint) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+//		return nulljsonv2.UnmarshalJSONFrom(dec, i)
+//	}
+func UnmarshalJSONFrom(dec *jsontext.Decoder, v JSONUnmarshaler) error {
+	val, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+	return v.UnmarshalJSON(val)
+}
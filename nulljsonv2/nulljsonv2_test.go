@@ -0,0 +1,49 @@
+//go:build goexperiment.jsonv2
+
+package nulljsonv2
+
+import (
+	"encoding/json/jsontext"
+	"strings"
+	"testing"
+)
+
+type fakeMarshaler struct {
+	data string
+}
+
+func (f fakeMarshaler) MarshalJSON() ([]byte, error) {
+	return []byte(f.data), nil
+}
+
+type fakeUnmarshaler struct {
+	got string
+}
+
+func (f *fakeUnmarshaler) UnmarshalJSON(data []byte) error {
+	f.got = string(data)
+	return nil
+}
+
+func TestMarshalJSONTo(t *testing.T) {
+	var buf strings.Builder
+	enc := jsontext.NewEncoder(&buf)
+	if err := MarshalJSONTo(enc, fakeMarshaler{data: "12345"}); err != nil {
+		t.Fatal(err)
+	}
+	// jsontext.Encoder terminates each top-level value with a newline.
+	if buf.String() != "12345\n" {
+		t.Errorf("got %q, want %q", buf.String(), "12345\n")
+	}
+}
+
+func TestUnmarshalJSONFrom(t *testing.T) {
+	dec := jsontext.NewDecoder(strings.NewReader(`12345`))
+	var f fakeUnmarshaler
+	if err := UnmarshalJSONFrom(dec, &f); err != nil {
+		t.Fatal(err)
+	}
+	if f.got != "12345" {
+		t.Errorf("got %q, want %q", f.got, "12345")
+	}
+}
@@ -2,36 +2,39 @@ package null
 
 import (
 	"bytes"
-	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
+
+	"github.com/toby1991/null/nulljson"
 )
 
-// Uint is an nullable uint64.
+// Uint is a nullable uint64, implemented as a thin wrapper over
+// Variable[uint64].
 // It does not consider zero values to be null.
 // It will decode to null, not zero, if null.
+//
+// UnmarshalJSON and Scan are overridden here for uint64-specific input (the
+// struct-shaped JSON form below, and a []byte/string Scan source) that
+// Variable has no way to express generically. ValueOrZero, SetValid,
+// IsZero, MarshalText, UnmarshalText, and Value are inherited from
+// Variable[uint64].
 type Uint struct {
-	sql.NullString
+	Variable[uint64]
 }
 
-// NewInt creates a new Uint
+// NewUint creates a new Uint
 func NewUint(i uint64, valid bool) Uint {
-	return Uint{
-		NullString: sql.NullString{
-			String: strconv.FormatUint(i, 10),
-			Valid:  valid,
-		},
-	}
+	return Uint{Variable: NewVariable(i, valid)}
 }
 
-// IntFrom creates a new Uint that will always be valid.
+// UintFrom creates a new Uint that will always be valid.
 func UintFrom(i uint64) Uint {
 	return NewUint(i, true)
 }
 
-// IntFromPtr creates a new Uint that be null if i is nil.
+// UintFromPtr creates a new Uint that be null if i is nil.
 func UintFromPtr(i *uint64) Uint {
 	if i == nil {
 		return NewUint(0, false)
@@ -39,18 +42,13 @@ func UintFromPtr(i *uint64) Uint {
 	return NewUint(*i, true)
 }
 
-// ValueOrZero returns the inner value if valid, otherwise zero.
-func (i Uint) ValueOrZero() uint64 {
-	if !i.Valid {
-		return 0
-	}
-	parseUint, _ := strconv.ParseUint(i.String, 10, 64)
-	return parseUint
-}
-
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number, string, and null input.
 // 0 will not be considered a null Uint.
+//
+// It also accepts the struct-shaped {"Uint64":123,"Valid":true} form that
+// this type's own MarshalJSON doesn't produce, matching how sql.NullInt64
+// round-trips through encoding/json.
 func (i *Uint) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, nullBytes) {
 		i.Valid = false
@@ -62,92 +60,91 @@ func (i *Uint) UnmarshalJSON(data []byte) error {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
 			// special case: accept string input
-			if typeError.Value != "string" {
-				return fmt.Errorf("null: JSON input is invalid type (need int or string): %w", err)
-			}
-			var str string
-			if err := json.Unmarshal(data, &str); err != nil {
-				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			if typeError.Value == "string" {
+				var str string
+				if err := json.Unmarshal(data, &str); err != nil {
+					return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+				}
+				n, err := strconv.ParseUint(str, 10, 64)
+				if err != nil {
+					return fmt.Errorf("null: couldn't convert string to int: %w", err)
+				}
+				i.val = n
+				i.Valid = true
+				return nil
 			}
-			n, err := strconv.ParseUint(str, 10, 64)
-			if err != nil {
-				return fmt.Errorf("null: couldn't convert string to int: %w", err)
+			// special case: accept the {"Uint64":...,"Valid":...} struct form
+			if typeError.Value == "object" {
+				var aux struct {
+					Uint64 uint64
+					Valid  bool
+				}
+				if err := json.Unmarshal(data, &aux); err != nil {
+					return fmt.Errorf("null: couldn't unmarshal struct form: %w", err)
+				}
+				i.val = aux.Uint64
+				i.Valid = aux.Valid
+				return nil
 			}
-			i.String = strconv.FormatUint(n, 10)
-			i.Valid = true
-			return nil
+			return fmt.Errorf("null: JSON input is invalid type (need int or string): %w", err)
 		}
 		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
 	}
 
-	i.String = strconv.FormatUint(_n, 10)
-	i.Valid = true
-	return nil
-}
-
-// UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Uint if the input is blank.
-// It will return an error if the input is not an integer, blank, or "null".
-func (i *Uint) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
-	n, err := strconv.ParseUint(str, 10, 64)
-	if err != nil {
-		return fmt.Errorf("null: couldn't convert string to int: %w", err)
-	}
-	i.String = strconv.FormatUint(n, 10)
+	i.val = _n
 	i.Valid = true
 	return nil
 }
 
-// MarshalJSON implements json.Marshaler.
-// It will encode null if this Uint is null.
-func (i Uint) MarshalJSON() ([]byte, error) {
-	if !i.Valid {
-		return []byte("null"), nil
-	}
-
-	return []byte(i.String), nil
-}
-
-// MarshalText implements encoding.TextMarshaler.
-// It will encode a blank string if this Uint is null.
-func (i Uint) MarshalText() ([]byte, error) {
+// Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
+func (i Uint) Ptr() *uint64 {
 	if !i.Valid {
-		return []byte{}, nil
+		return nil
 	}
-	return []byte(i.String), nil
+	return &i.val
 }
 
-// SetValid changes this Uint's value and also sets it to be non-null.
-func (i *Uint) SetValid(n uint64) {
-	i.String = strconv.FormatUint(n, 10)
-	i.Valid = true
+// Equal returns true if both ints have the same value or are both null.
+func (i Uint) Equal(other Uint) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.val == other.val)
 }
 
-// Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
-func (i Uint) Ptr() *uint64 {
-	if !i.Valid {
+// Scan implements the sql.Scanner interface.
+func (i *Uint) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
 		return nil
 	}
-
-	parseUint, err := strconv.ParseUint(i.String, 10, 64)
-	if err != nil {
-		return nil
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("null: %d overflows uint64", v)
+		}
+		i.val = uint64(v)
+	case uint64:
+		i.val = v
+	case []byte:
+		n, err := strconv.ParseUint(string(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan []byte into uint64: %w", err)
+		}
+		i.val = n
+	case string:
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan string into uint64: %w", err)
+		}
+		i.val = n
+	default:
+		return fmt.Errorf("null: cannot scan type %T into null.Uint: %v", value, value)
 	}
-	return &parseUint
-}
-
-// IsZero returns true for invalid Ints, for future omitempty support (Go 1.4?)
-// A non-null Uint with a 0 value will not be considered zero.
-func (i Uint) IsZero() bool {
-	return !i.Valid
+	i.Valid = true
+	return nil
 }
 
-// Equal returns true if both ints have the same value or are both null.
-func (i Uint) Equal(other Uint) bool {
-	return i.Valid == other.Valid && (!i.Valid || i.String == other.String)
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Uint) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
 }
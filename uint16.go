@@ -0,0 +1,138 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/toby1991/null/nulljson"
+)
+
+// Uint16 is a nullable uint16, implemented as a thin wrapper over
+// Variable[uint16].
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+//
+// UnmarshalJSON and Scan are overridden here for uint16-specific overflow
+// checking and string-accepting input that Variable has no way to express
+// generically. ValueOrZero, SetValid, IsZero, MarshalText, UnmarshalText,
+// and Value are inherited from Variable[uint16].
+type Uint16 struct {
+	Variable[uint16]
+}
+
+// NewUint16 creates a new Uint16
+func NewUint16(i uint16, valid bool) Uint16 {
+	return Uint16{Variable: NewVariable(i, valid)}
+}
+
+// Uint16From creates a new Uint16 that will always be valid.
+func Uint16From(i uint16) Uint16 {
+	return NewUint16(i, true)
+}
+
+// Uint16FromPtr creates a new Uint16 that be null if i is nil.
+func Uint16FromPtr(i *uint16) Uint16 {
+	if i == nil {
+		return NewUint16(0, false)
+	}
+	return NewUint16(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint16.
+func (i *Uint16) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	var _n uint64
+	if err := json.Unmarshal(data, &_n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" {
+				return fmt.Errorf("null: JSON input is invalid type (need int or string): %w", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseUint(str, 10, 16)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to uint16: %w", err)
+			}
+			i.val = uint16(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if _n > math.MaxUint16 {
+		return fmt.Errorf("null: %d overflows uint16", _n)
+	}
+	i.val = uint16(_n)
+	i.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this Uint16's value, or a nil pointer if this Uint16 is null.
+func (i Uint16) Ptr() *uint16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.val
+}
+
+// Equal returns true if both uint16s have the same value or are both null.
+func (i Uint16) Equal(other Uint16) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.val == other.val)
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Uint16) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < 0 || uint64(v) > math.MaxUint16 {
+			return fmt.Errorf("null: %d overflows uint16", v)
+		}
+		i.val = uint16(v)
+	case uint64:
+		if v > math.MaxUint16 {
+			return fmt.Errorf("null: %d overflows uint16", v)
+		}
+		i.val = uint16(v)
+	case []byte:
+		n, err := strconv.ParseUint(string(v), 10, 16)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan []byte into uint16: %w", err)
+		}
+		i.val = uint16(n)
+	case string:
+		n, err := strconv.ParseUint(v, 10, 16)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan string into uint16: %w", err)
+		}
+		i.val = uint16(n)
+	default:
+		return fmt.Errorf("null: cannot scan type %T into null.Uint16: %v", value, value)
+	}
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Uint16) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
+}
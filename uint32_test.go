@@ -0,0 +1,285 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	uint32JSON       = []byte(`12345`)
+	uint32StringJSON = []byte(`"12345"`)
+)
+
+func TestUint32From(t *testing.T) {
+	i := Uint32From(12345)
+	assertUint32(t, i, "Uint32From()")
+
+	zero := Uint32From(0)
+	if !zero.Valid {
+		t.Error("Uint32From(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestUint32FromPtr(t *testing.T) {
+	n := uint32(12345)
+	iptr := &n
+	i := Uint32FromPtr(iptr)
+	assertUint32(t, i, "Uint32FromPtr()")
+
+	null := Uint32FromPtr(nil)
+	assertNullUint32(t, null, "Uint32FromPtr(nil)")
+}
+
+func TestUnmarshalUint32(t *testing.T) {
+	var i Uint32
+	err := json.Unmarshal(uint32JSON, &i)
+	maybePanic(err)
+	assertUint32(t, i, "uint32 json")
+
+	var si Uint32
+	err = json.Unmarshal(uint32StringJSON, &si)
+	maybePanic(err)
+	assertUint32(t, si, "uint32 string json")
+
+	var null Uint32
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint32(t, null, "null json")
+
+	var badType Uint32
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullUint32(t, badType, "wrong type json")
+
+	var invalid Uint32
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullUint32(t, invalid, "invalid json")
+}
+
+func TestUnmarshalUint32Overflow(t *testing.T) {
+	overflow := uint64(math.MaxUint32) + 1
+
+	var i Uint32
+	err := json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows uint32")
+	}
+}
+
+func TestTextUnmarshalUint32(t *testing.T) {
+	var i Uint32
+	err := i.UnmarshalText([]byte("12345"))
+	maybePanic(err)
+	assertUint32(t, i, "UnmarshalText() uint32")
+
+	var blank Uint32
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullUint32(t, blank, "UnmarshalText() empty uint32")
+
+	var null Uint32
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullUint32(t, null, `UnmarshalText() "null"`)
+
+	var invalid Uint32
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalUint32(t *testing.T) {
+	i := Uint32From(12345)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty json marshal")
+
+	null := NewUint32(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null json marshal")
+}
+
+func TestMarshalUint32Text(t *testing.T) {
+	i := Uint32From(12345)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty text marshal")
+
+	null := NewUint32(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text marshal")
+}
+
+func TestUint32Pointer(t *testing.T) {
+	i := Uint32From(12345)
+	ptr := i.Ptr()
+	if *ptr != 12345 {
+		t.Errorf("bad %s uint32: %#v ≠ %d\n", "pointer", ptr, 12345)
+	}
+
+	null := NewUint32(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint32: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUint32IsZero(t *testing.T) {
+	i := Uint32From(12345)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewUint32(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewUint32(0, true)
+	if zero.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+}
+
+func TestUint32SetValid(t *testing.T) {
+	change := NewUint32(0, false)
+	assertNullUint32(t, change, "SetValid()")
+	change.SetValid(12345)
+	assertUint32(t, change, "SetValid()")
+}
+
+func TestUint32Scan(t *testing.T) {
+	var i Uint32
+	err := i.Scan(int64(12345))
+	maybePanic(err)
+	assertUint32(t, i, "scanned uint32")
+
+	var null Uint32
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint32(t, null, "scanned null")
+}
+
+func TestUint32ScanOverflow(t *testing.T) {
+	var i Uint32
+	err := i.Scan(uint64(math.MaxUint32) + 1)
+	if err == nil {
+		panic("err should be present; scanned value overflows uint32")
+	}
+}
+
+func TestUint32Value(t *testing.T) {
+	v, err := Uint32From(12345).Value()
+	maybePanic(err)
+	if v != int64(12345) {
+		t.Errorf("bad Value(): %#v ≠ %v", v, int64(12345))
+	}
+
+	v, err = NewUint32(0, false).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad Value(): %#v is not nil", v)
+	}
+}
+
+func TestUint32ValueOrZero(t *testing.T) {
+	valid := NewUint32(12345, true)
+	if valid.ValueOrZero() != 12345 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUint32(12345, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestUint32Equal(t *testing.T) {
+	int1 := NewUint32(10, false)
+	int2 := NewUint32(10, false)
+	assertUint32EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint32(10, false)
+	int2 = NewUint32(20, false)
+	assertUint32EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint32(10, true)
+	int2 = NewUint32(10, true)
+	assertUint32EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint32(10, true)
+	int2 = NewUint32(10, false)
+	assertUint32EqualIsFalse(t, int1, int2)
+
+	int1 = NewUint32(10, false)
+	int2 = NewUint32(10, true)
+	assertUint32EqualIsFalse(t, int1, int2)
+
+	int1 = NewUint32(10, true)
+	int2 = NewUint32(20, true)
+	assertUint32EqualIsFalse(t, int1, int2)
+}
+
+func assertUint32(t *testing.T, i Uint32, from string) {
+	if i.ValueOrZero() != 12345 {
+		t.Errorf("bad %s uint32: %v ≠ %d\n", from, i.ValueOrZero(), 12345)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint32(t *testing.T, i Uint32, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertUint32EqualIsTrue(t *testing.T, a, b Uint32) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Uint32{%v, Valid:%t} and Uint32{%v, Valid:%t} should return true", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func assertUint32EqualIsFalse(t *testing.T, a, b Uint32) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Uint32{%v, Valid:%t} and Uint32{%v, Valid:%t} should return false", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func TestUint32UnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader(bytes.Join([][]byte{uint32JSON, uint32StringJSON, nullJSON}, []byte(" "))))
+
+	var a Uint32
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertUint32(t, a, "UnmarshalNext() number")
+
+	var b Uint32
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertUint32(t, b, "UnmarshalNext() string")
+
+	var c Uint32
+	if err := c.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertNullUint32(t, c, "UnmarshalNext() null")
+}
@@ -0,0 +1,15 @@
+package null
+
+// Uint64 is an alias of Uint, provided for symmetry with the other
+// fixed-width nullable integer types. Uint is uint64-backed, so there is
+// nothing more for this type to do.
+type Uint64 = Uint
+
+// NewUint64 creates a new Uint64.
+var NewUint64 = NewUint
+
+// Uint64From creates a new Uint64 that will always be valid.
+var Uint64From = UintFrom
+
+// Uint64FromPtr creates a new Uint64 that will be null if i is nil.
+var Uint64FromPtr = UintFromPtr
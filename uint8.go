@@ -0,0 +1,138 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/toby1991/null/nulljson"
+)
+
+// Uint8 is a nullable uint8, implemented as a thin wrapper over
+// Variable[uint8].
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+//
+// UnmarshalJSON and Scan are overridden here for uint8-specific overflow
+// checking and string-accepting input that Variable has no way to express
+// generically. ValueOrZero, SetValid, IsZero, MarshalText, UnmarshalText,
+// and Value are inherited from Variable[uint8].
+type Uint8 struct {
+	Variable[uint8]
+}
+
+// NewUint8 creates a new Uint8
+func NewUint8(i uint8, valid bool) Uint8 {
+	return Uint8{Variable: NewVariable(i, valid)}
+}
+
+// Uint8From creates a new Uint8 that will always be valid.
+func Uint8From(i uint8) Uint8 {
+	return NewUint8(i, true)
+}
+
+// Uint8FromPtr creates a new Uint8 that be null if i is nil.
+func Uint8FromPtr(i *uint8) Uint8 {
+	if i == nil {
+		return NewUint8(0, false)
+	}
+	return NewUint8(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will not be considered a null Uint8.
+func (i *Uint8) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	var _n uint64
+	if err := json.Unmarshal(data, &_n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" {
+				return fmt.Errorf("null: JSON input is invalid type (need int or string): %w", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("null: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseUint(str, 10, 8)
+			if err != nil {
+				return fmt.Errorf("null: couldn't convert string to uint8: %w", err)
+			}
+			i.val = uint8(n)
+			i.Valid = true
+			return nil
+		}
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	if _n > math.MaxUint8 {
+		return fmt.Errorf("null: %d overflows uint8", _n)
+	}
+	i.val = uint8(_n)
+	i.Valid = true
+	return nil
+}
+
+// Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
+func (i Uint8) Ptr() *uint8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.val
+}
+
+// Equal returns true if both uint8s have the same value or are both null.
+func (i Uint8) Equal(other Uint8) bool {
+	return i.Valid == other.Valid && (!i.Valid || i.val == other.val)
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Uint8) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < 0 || uint64(v) > math.MaxUint8 {
+			return fmt.Errorf("null: %d overflows uint8", v)
+		}
+		i.val = uint8(v)
+	case uint64:
+		if v > math.MaxUint8 {
+			return fmt.Errorf("null: %d overflows uint8", v)
+		}
+		i.val = uint8(v)
+	case []byte:
+		n, err := strconv.ParseUint(string(v), 10, 8)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan []byte into uint8: %w", err)
+		}
+		i.val = uint8(n)
+	case string:
+		n, err := strconv.ParseUint(v, 10, 8)
+		if err != nil {
+			return fmt.Errorf("null: couldn't scan string into uint8: %w", err)
+		}
+		i.val = uint8(n)
+	default:
+		return fmt.Errorf("null: cannot scan type %T into null.Uint8: %v", value, value)
+	}
+	i.Valid = true
+	return nil
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Uint8) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
+}
@@ -47,9 +47,8 @@ func TestUnmarshalUint(t *testing.T) {
 
 	var ni Uint
 	err = json.Unmarshal(nullUintJSON, &ni)
-	if err == nil {
-		panic("err should not be nill")
-	}
+	maybePanic(err)
+	assertUint(t, ni, "struct-form json")
 
 	var bi Uint
 	err = json.Unmarshal(floatBlankJSON, &bi)
@@ -191,7 +190,7 @@ func TestUintSetValid(t *testing.T) {
 
 func TestUintScan(t *testing.T) {
 	var i Uint
-	err := i.Scan(12345)
+	err := i.Scan(int64(12345))
 	maybePanic(err)
 	assertUint(t, i, "scanned uint")
 
@@ -201,6 +200,29 @@ func TestUintScan(t *testing.T) {
 	assertNullUint(t, null, "scanned null")
 }
 
+func TestUintValue(t *testing.T) {
+	v, err := UintFrom(12345).Value()
+	maybePanic(err)
+	if v != int64(12345) {
+		t.Errorf("bad Value(): %#v is not an int64 of 12345", v)
+	}
+
+	// values that overflow int64 aren't a driver.Value on their own; they
+	// must come back as a string, not a bare uint64.
+	wide := NewUint(math.MaxUint64, true)
+	v, err = wide.Value()
+	maybePanic(err)
+	if v != strconv.FormatUint(math.MaxUint64, 10) {
+		t.Errorf("bad Value(): %#v ≠ %q", v, strconv.FormatUint(math.MaxUint64, 10))
+	}
+
+	v, err = NewUint(0, false).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("bad Value(): %#v is not nil", v)
+	}
+}
+
 func TestUintValueOrZero(t *testing.T) {
 	valid := NewUint(12345, true)
 	if valid.ValueOrZero() != 12345 {
@@ -240,8 +262,8 @@ func TestUintEqual(t *testing.T) {
 }
 
 func assertUint(t *testing.T, i Uint, from string) {
-	if i.String != "12345" {
-		t.Errorf("bad %s uint: %s ≠ %s\n", from, i.String, "12345")
+	if i.ValueOrZero() != 12345 {
+		t.Errorf("bad %s uint: %d ≠ %d\n", from, i.ValueOrZero(), 12345)
 	}
 	if !i.Valid {
 		t.Error(from, "is invalid, but should be valid")
@@ -257,13 +279,13 @@ func assertNullUint(t *testing.T, i Uint, from string) {
 func assertUintEqualIsTrue(t *testing.T, a, b Uint) {
 	t.Helper()
 	if !a.Equal(b) {
-		t.Errorf("Equal() of Uint{%v, Valid:%t} and Uint{%v, Valid:%t} should return true", a.String, a.Valid, b.String, b.Valid)
+		t.Errorf("Equal() of Uint{%v, Valid:%t} and Uint{%v, Valid:%t} should return true", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
 	}
 }
 
 func assertUintEqualIsFalse(t *testing.T, a, b Uint) {
 	t.Helper()
 	if a.Equal(b) {
-		t.Errorf("Equal() of Uint{%v, Valid:%t} and Uint{%v, Valid:%t} should return false", a.String, a.Valid, b.String, b.Valid)
+		t.Errorf("Equal() of Uint{%v, Valid:%t} and Uint{%v, Valid:%t} should return false", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
 	}
 }
@@ -0,0 +1,176 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/toby1991/null/nulldriver"
+	"github.com/toby1991/null/nulljson"
+)
+
+// Variable is a generic nullable value of type T.
+// It does not consider the zero value of T to be null.
+// It will decode to null, not the zero value, if null.
+//
+// The concrete types in this package (Uint, Int8, Float64, and so on) embed
+// Variable and inherit most of their methods from it, overriding Scan and the
+// JSON/text methods where they need things like width-specific overflow
+// checking that Variable has no way to express generically. Variable can also
+// be used directly as a nullable wrapper around an arbitrary type, such as a
+// user-defined struct or a standard library type like time.Duration.
+type Variable[T any] struct {
+	val   T
+	Valid bool
+}
+
+// NewVariable creates a new Variable[T].
+func NewVariable[T any](v T, valid bool) Variable[T] {
+	return Variable[T]{val: v, Valid: valid}
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero value of T.
+//
+// There is no separate Value() T accessor: that name is reserved for the
+// driver.Valuer implementation below, matching every other type in this
+// package.
+func (v Variable[T]) ValueOrZero() T {
+	if !v.Valid {
+		var zero T
+		return zero
+	}
+	return v.val
+}
+
+// Reset clears the value back to invalid and the zero value of T.
+func (v *Variable[T]) Reset() {
+	var zero T
+	v.val = zero
+	v.Valid = false
+}
+
+// IsNil returns true if this Variable is null.
+func (v Variable[T]) IsNil() bool {
+	return !v.Valid
+}
+
+// NotNil returns true if this Variable is not null.
+func (v Variable[T]) NotNil() bool {
+	return v.Valid
+}
+
+// SetValid changes this Variable's value and also sets it to be non-null.
+func (v *Variable[T]) SetValid(n T) {
+	v.val = n
+	v.Valid = true
+}
+
+// IsZero returns true for invalid Variables, for future omitempty support (Go 1.4?)
+func (v Variable[T]) IsZero() bool {
+	return !v.Valid
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Variable is null.
+func (v Variable[T]) MarshalJSON() ([]byte, error) {
+	if !v.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(v.val)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Variable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		v.Valid = false
+		return nil
+	}
+	if err := json.Unmarshal(data, &v.val); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal JSON: %w", err)
+	}
+	v.Valid = true
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Variable is null.
+// If T implements encoding.TextMarshaler, that is used; otherwise the value
+// is formatted with fmt.
+func (v Variable[T]) MarshalText() ([]byte, error) {
+	if !v.Valid {
+		return []byte{}, nil
+	}
+	if m, ok := any(v.val).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	return []byte(fmt.Sprint(v.val)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Variable if the input is blank.
+// If T implements encoding.TextUnmarshaler, that is used; otherwise the value
+// is scanned with fmt.
+func (v *Variable[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 || string(text) == "null" {
+		v.Valid = false
+		return nil
+	}
+	if u, ok := any(&v.val).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+		}
+		v.Valid = true
+		return nil
+	}
+	if _, err := fmt.Sscan(string(text), &v.val); err != nil {
+		return fmt.Errorf("null: couldn't unmarshal text: %w", err)
+	}
+	v.Valid = true
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (v *Variable[T]) Scan(value interface{}) error {
+	if value == nil {
+		var zero T
+		v.val = zero
+		v.Valid = false
+		return nil
+	}
+	if n, ok := value.(T); ok {
+		v.val = n
+		v.Valid = true
+		return nil
+	}
+	// The driver's concrete type (e.g. int64) doesn't match T exactly, but
+	// may still be convertible to it (e.g. time.Duration is backed by int64).
+	rv := reflect.ValueOf(value)
+	rt := reflect.TypeOf(v.val)
+	if rv.Type().ConvertibleTo(rt) {
+		v.val = rv.Convert(rt).Interface().(T)
+		v.Valid = true
+		return nil
+	}
+	return fmt.Errorf("null: cannot scan type %T into null.Variable[%T]", value, v.val)
+}
+
+// Value implements the driver.Valuer interface. v.val is converted the way
+// database/sql/driver.DefaultParameterConverter would, since T's underlying
+// type (e.g. time.Duration, whose kind is int64) won't generally already be
+// one of the types driver.Value allows.
+func (v Variable[T]) Value() (driver.Value, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	return nulldriver.Value(v.val)
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (v *Variable[T]) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, v)
+}
@@ -0,0 +1,169 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// fakeDriver is a minimal database/sql/driver.Driver that echoes back
+// whatever driver.Value it was given as a query argument, so
+// TestVariableDurationSQL can round-trip a Variable through an actual
+// sql.DB instead of calling Value()/Scan() directly -- which wouldn't have
+// caught Value() handing database/sql a type it doesn't accept.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fakeDriver: transactions not supported")
+}
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("fakeDriver: Exec not supported")
+}
+func (fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{vals: args}, nil
+}
+
+type fakeRows struct {
+	vals []driver.Value
+	read bool
+}
+
+func (*fakeRows) Columns() []string { return []string{"v"} }
+func (*fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.read || len(r.vals) == 0 {
+		return io.EOF
+	}
+	dest[0] = r.vals[0]
+	r.read = true
+	return nil
+}
+
+func init() {
+	sql.Register("nulltest_fake", fakeDriver{})
+}
+
+func TestVariableDurationJSON(t *testing.T) {
+	v := NewVariable(5*time.Second, true)
+
+	data, err := json.Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, "5000000000", "duration json marshal")
+
+	var decoded Variable[time.Duration]
+	err = json.Unmarshal(data, &decoded)
+	maybePanic(err)
+	if !decoded.Valid {
+		t.Error("decoded Variable[time.Duration] should be valid")
+	}
+	if decoded.ValueOrZero() != 5*time.Second {
+		t.Errorf("bad duration: %v ≠ %v", decoded.ValueOrZero(), 5*time.Second)
+	}
+
+	var null Variable[time.Duration]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null Variable[time.Duration] should be invalid")
+	}
+}
+
+func TestVariableDurationSQL(t *testing.T) {
+	db, err := sql.Open("nulltest_fake", "")
+	maybePanic(err)
+	defer db.Close()
+
+	// time.Duration's underlying kind is int64, not one of the types
+	// driver.Value allows on its own -- this only works if Value() actually
+	// converts it, which calling Value()/Scan() directly in-process
+	// wouldn't have caught.
+	v := NewVariable(5*time.Second, true)
+	var scanned Variable[time.Duration]
+	err = db.QueryRow("SELECT ?", v).Scan(&scanned)
+	maybePanic(err)
+	if !scanned.Valid {
+		t.Error("scanned Variable[time.Duration] should be valid")
+	}
+	if scanned.ValueOrZero() != 5*time.Second {
+		t.Errorf("bad duration: %v ≠ %v", scanned.ValueOrZero(), 5*time.Second)
+	}
+
+	var fromInt64 Variable[time.Duration]
+	err = fromInt64.Scan(int64(5 * time.Second))
+	maybePanic(err)
+	if fromInt64.ValueOrZero() != 5*time.Second {
+		t.Errorf("bad duration scanned from int64: %v ≠ %v", fromInt64.ValueOrZero(), 5*time.Second)
+	}
+
+	var null Variable[time.Duration]
+	err = db.QueryRow("SELECT ?", NewVariable(time.Duration(0), false)).Scan(&null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("scanned null Variable[time.Duration] should be invalid")
+	}
+}
+
+func TestVariableReset(t *testing.T) {
+	v := NewVariable(12345, true)
+	v.Reset()
+	if v.Valid {
+		t.Error("Reset() should make the Variable invalid")
+	}
+	if v.ValueOrZero() != 0 {
+		t.Error("Reset() should zero the value")
+	}
+}
+
+func TestVariableIsNilNotNil(t *testing.T) {
+	v := NewVariable("hello", true)
+	if v.IsNil() {
+		t.Error("IsNil() should be false")
+	}
+	if !v.NotNil() {
+		t.Error("NotNil() should be true")
+	}
+
+	null := NewVariable("", false)
+	if !null.IsNil() {
+		t.Error("IsNil() should be true")
+	}
+	if null.NotNil() {
+		t.Error("NotNil() should be false")
+	}
+}
+
+func TestVariableUnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(`5000000000 null`)))
+
+	var a Variable[time.Duration]
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	if !a.Valid || a.ValueOrZero() != 5*time.Second {
+		t.Errorf("bad UnmarshalNext() duration: %v", a.ValueOrZero())
+	}
+
+	var b Variable[time.Duration]
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	if b.Valid {
+		t.Error("UnmarshalNext() null should be invalid")
+	}
+}
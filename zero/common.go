@@ -0,0 +1,5 @@
+package zero
+
+// nullBytes is the JSON representation of a null value, shared by every
+// nullable type's UnmarshalJSON for the bytes.Equal null check.
+var nullBytes = []byte("null")
@@ -0,0 +1,274 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var (
+	float64JSON       = []byte(`1.2345`)
+	float64StringJSON = []byte(`"1.2345"`)
+	float64ZeroJSON   = []byte(`0`)
+)
+
+func TestFloat64From(t *testing.T) {
+	f := Float64From(1.2345)
+	assertFloat64(t, f, "Float64From()")
+
+	zero := Float64From(0)
+	if zero.Valid {
+		t.Error("Float64From(0)", "is valid, but should be invalid")
+	}
+}
+
+func TestFloat64FromPtr(t *testing.T) {
+	n := float64(1.2345)
+	fptr := &n
+	f := Float64FromPtr(fptr)
+	assertFloat64(t, f, "Float64FromPtr()")
+
+	null := Float64FromPtr(nil)
+	assertNullFloat64(t, null, "Float64FromPtr(nil)")
+}
+
+func TestUnmarshalFloat64(t *testing.T) {
+	var f Float64
+	err := json.Unmarshal(float64JSON, &f)
+	maybePanic(err)
+	assertFloat64(t, f, "float64 json")
+
+	var sf Float64
+	err = json.Unmarshal(float64StringJSON, &sf)
+	maybePanic(err)
+	assertFloat64(t, sf, "float64 string json")
+
+	var zero Float64
+	err = json.Unmarshal(float64ZeroJSON, &zero)
+	maybePanic(err)
+	assertNullFloat64(t, zero, "zero json")
+
+	var null Float64
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullFloat64(t, null, "null json")
+
+	var badType Float64
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullFloat64(t, badType, "wrong type json")
+
+	var invalid Float64
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullFloat64(t, invalid, "invalid json")
+}
+
+func TestTextUnmarshalFloat64(t *testing.T) {
+	var f Float64
+	err := f.UnmarshalText([]byte("1.2345"))
+	maybePanic(err)
+	assertFloat64(t, f, "UnmarshalText() float64")
+
+	var blank Float64
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullFloat64(t, blank, "UnmarshalText() empty float64")
+
+	var zero Float64
+	err = zero.UnmarshalText([]byte("0"))
+	maybePanic(err)
+	assertNullFloat64(t, zero, "UnmarshalText() zero float64")
+
+	var null Float64
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullFloat64(t, null, `UnmarshalText() "null"`)
+
+	var invalid Float64
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalFloat64(t *testing.T) {
+	f := Float64From(1.2345)
+	data, err := json.Marshal(f)
+	maybePanic(err)
+	assertJSONEquals(t, data, "1.2345", "non-empty json marshal")
+
+	null := NewFloat64(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal")
+}
+
+func TestMarshalFloat64Text(t *testing.T) {
+	f := Float64From(1.2345)
+	data, err := f.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "1.2345", "non-empty text marshal")
+
+	null := NewFloat64(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null text marshal")
+}
+
+func TestFloat64Pointer(t *testing.T) {
+	f := Float64From(1.2345)
+	ptr := f.Ptr()
+	if *ptr != 1.2345 {
+		t.Errorf("bad %s float64: %#v ≠ %v\n", "pointer", ptr, 1.2345)
+	}
+
+	null := NewFloat64(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s float64: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestFloat64IsZero(t *testing.T) {
+	f := Float64From(1.2345)
+	if f.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewFloat64(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewFloat64(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestFloat64SetValid(t *testing.T) {
+	change := NewFloat64(0, false)
+	assertNullFloat64(t, change, "SetValid()")
+	change.SetValid(1.2345)
+	assertFloat64(t, change, "SetValid()")
+}
+
+func TestFloat64Scan(t *testing.T) {
+	var f Float64
+	err := f.Scan(1.2345)
+	maybePanic(err)
+	assertFloat64(t, f, "scanned float64")
+
+	var null Float64
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullFloat64(t, null, "scanned null")
+}
+
+func TestFloat64Value(t *testing.T) {
+	v, err := Float64From(1.2345).Value()
+	maybePanic(err)
+	if v != float64(1.2345) {
+		t.Errorf("bad Value(): %#v ≠ %v", v, float64(1.2345))
+	}
+
+	// a null Float64 is considered zero, so it still has to produce a valid
+	// driver.Value rather than nil.
+	v, err = NewFloat64(0, false).Value()
+	maybePanic(err)
+	if v != float64(0) {
+		t.Errorf("bad Value(): %#v is not a float64 of 0", v)
+	}
+}
+
+func TestFloat64ValueOrZero(t *testing.T) {
+	valid := NewFloat64(1.2345, true)
+	if valid.ValueOrZero() != 1.2345 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewFloat64(1.2345, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestFloat64Equal(t *testing.T) {
+	float1 := NewFloat64(10, false)
+	float2 := NewFloat64(10, false)
+	assertFloat64EqualIsTrue(t, float1, float2)
+
+	float1 = NewFloat64(10, false)
+	float2 = NewFloat64(20, false)
+	assertFloat64EqualIsTrue(t, float1, float2)
+
+	float1 = NewFloat64(10, true)
+	float2 = NewFloat64(10, true)
+	assertFloat64EqualIsTrue(t, float1, float2)
+
+	float1 = NewFloat64(0, true)
+	float2 = NewFloat64(0, false)
+	assertFloat64EqualIsTrue(t, float1, float2)
+
+	float1 = NewFloat64(10, true)
+	float2 = NewFloat64(20, true)
+	assertFloat64EqualIsFalse(t, float1, float2)
+}
+
+func assertFloat64(t *testing.T, f Float64, from string) {
+	if f.ValueOrZero() != 1.2345 {
+		t.Errorf("bad %s float64: %v ≠ %v\n", from, f.ValueOrZero(), 1.2345)
+	}
+	if !f.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullFloat64(t *testing.T, f Float64, from string) {
+	if f.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertFloat64EqualIsTrue(t *testing.T, a, b Float64) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Float64{%v, Valid:%t} and Float64{%v, Valid:%t} should return true", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func assertFloat64EqualIsFalse(t *testing.T, a, b Float64) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Float64{%v, Valid:%t} and Float64{%v, Valid:%t} should return false", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func TestFloat64UnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader(bytes.Join([][]byte{float64JSON, float64StringJSON, nullJSON}, []byte(" "))))
+
+	var a Float64
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertFloat64(t, a, "UnmarshalNext() number")
+
+	var b Float64
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertFloat64(t, b, "UnmarshalNext() string")
+
+	var c Float64
+	if err := c.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertNullFloat64(t, c, "UnmarshalNext() null")
+}
@@ -0,0 +1,133 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/toby1991/null/nulljson"
+)
+
+// Int16 is a nullable int16, implemented as a thin wrapper over
+// Variable[int16].
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+//
+// UnmarshalJSON and Scan are overridden here for int16-specific overflow
+// checking and string-accepting input that Variable has no way to express
+// generically. ValueOrZero, SetValid, MarshalText, UnmarshalText, and Value
+// are inherited from Variable[int16].
+type Int16 struct {
+	Variable[int16]
+}
+
+// NewInt16 creates a new Int16
+func NewInt16(i int16, valid bool) Int16 {
+	return Int16{Variable: NewVariable(i, valid)}
+}
+
+// Int16From creates a new Int16 that will be null if zero.
+func Int16From(i int16) Int16 {
+	return NewInt16(i, i != 0)
+}
+
+// Int16FromPtr creates a new Int16 that be null if i is nil.
+func Int16FromPtr(i *int16) Int16 {
+	if i == nil {
+		return NewInt16(0, false)
+	}
+	return NewInt16(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will be considered a null Int16.
+func (i *Int16) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	var _n int64
+	if err := json.Unmarshal(data, &_n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" {
+				return fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseInt(str, 10, 16)
+			if err != nil {
+				return fmt.Errorf("zero: couldn't convert string to int16: %w", err)
+			}
+			i.val = int16(n)
+			i.Valid = n != 0
+			return nil
+		}
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if _n < math.MinInt16 || _n > math.MaxInt16 {
+		return fmt.Errorf("zero: %d overflows int16", _n)
+	}
+	i.val = int16(_n)
+	i.Valid = _n != 0
+	return nil
+}
+
+// Ptr returns a pointer to this Int16's value, or a nil pointer if this Int16 is null.
+func (i Int16) Ptr() *int16 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.val
+}
+
+// Equal returns true if both int16s have the same value or are both either null or zero.
+func (i Int16) Equal(other Int16) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Int16) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < math.MinInt16 || v > math.MaxInt16 {
+			return fmt.Errorf("zero: %d overflows int16", v)
+		}
+		i.val = int16(v)
+	case []byte:
+		n, err := strconv.ParseInt(string(v), 10, 16)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan []byte into int16: %w", err)
+		}
+		i.val = int16(n)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 16)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan string into int16: %w", err)
+		}
+		i.val = int16(n)
+	default:
+		return fmt.Errorf("zero: cannot scan type %T into zero.Int16: %v", value, value)
+	}
+	i.Valid = i.val != 0
+	return nil
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Int16) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
+}
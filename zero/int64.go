@@ -0,0 +1,133 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/toby1991/null/nulljson"
+)
+
+// Int64 is a nullable int64, implemented as a thin wrapper over
+// Variable[int64].
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+//
+// UnmarshalJSON and Scan are overridden here for int64-specific overflow
+// checking and string-accepting input that Variable has no way to express
+// generically. ValueOrZero, SetValid, MarshalText, UnmarshalText, and Value
+// are inherited from Variable[int64].
+type Int64 struct {
+	Variable[int64]
+}
+
+// NewInt64 creates a new Int64
+func NewInt64(i int64, valid bool) Int64 {
+	return Int64{Variable: NewVariable(i, valid)}
+}
+
+// Int64From creates a new Int64 that will be null if zero.
+func Int64From(i int64) Int64 {
+	return NewInt64(i, i != 0)
+}
+
+// Int64FromPtr creates a new Int64 that be null if i is nil.
+func Int64FromPtr(i *int64) Int64 {
+	if i == nil {
+		return NewInt64(0, false)
+	}
+	return NewInt64(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will be considered a null Int64.
+func (i *Int64) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	var _n int64
+	if err := json.Unmarshal(data, &_n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" {
+				return fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseInt(str, 10, 64)
+			if err != nil {
+				return fmt.Errorf("zero: couldn't convert string to int64: %w", err)
+			}
+			i.val = int64(n)
+			i.Valid = n != 0
+			return nil
+		}
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if _n < math.MinInt64 || _n > math.MaxInt64 {
+		return fmt.Errorf("zero: %d overflows int64", _n)
+	}
+	i.val = int64(_n)
+	i.Valid = _n != 0
+	return nil
+}
+
+// Ptr returns a pointer to this Int64's value, or a nil pointer if this Int64 is null.
+func (i Int64) Ptr() *int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.val
+}
+
+// Equal returns true if both int64s have the same value or are both either null or zero.
+func (i Int64) Equal(other Int64) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Int64) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < math.MinInt64 || v > math.MaxInt64 {
+			return fmt.Errorf("zero: %d overflows int64", v)
+		}
+		i.val = int64(v)
+	case []byte:
+		n, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan []byte into int64: %w", err)
+		}
+		i.val = int64(n)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan string into int64: %w", err)
+		}
+		i.val = int64(n)
+	default:
+		return fmt.Errorf("zero: cannot scan type %T into zero.Int64: %v", value, value)
+	}
+	i.Valid = i.val != 0
+	return nil
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Int64) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
+}
@@ -0,0 +1,300 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	int64JSON       = []byte(`100`)
+	int64StringJSON = []byte(`"100"`)
+	int64ZeroJSON   = []byte(`0`)
+)
+
+func TestInt64From(t *testing.T) {
+	i := Int64From(100)
+	assertInt64(t, i, "Int64From()")
+
+	zero := Int64From(0)
+	if zero.Valid {
+		t.Error("Int64From(0)", "is valid, but should be invalid")
+	}
+}
+
+func TestInt64FromPtr(t *testing.T) {
+	n := int64(100)
+	iptr := &n
+	i := Int64FromPtr(iptr)
+	assertInt64(t, i, "Int64FromPtr()")
+
+	null := Int64FromPtr(nil)
+	assertNullInt64(t, null, "Int64FromPtr(nil)")
+}
+
+func TestUnmarshalInt64(t *testing.T) {
+	var i Int64
+	err := json.Unmarshal(int64JSON, &i)
+	maybePanic(err)
+	assertInt64(t, i, "int64 json")
+
+	var si Int64
+	err = json.Unmarshal(int64StringJSON, &si)
+	maybePanic(err)
+	assertInt64(t, si, "int64 string json")
+
+	var zero Int64
+	err = json.Unmarshal(int64ZeroJSON, &zero)
+	maybePanic(err)
+	assertNullInt64(t, zero, "zero json")
+
+	var null Int64
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt64(t, null, "null json")
+
+	var badType Int64
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullInt64(t, badType, "wrong type json")
+
+	var invalid Int64
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullInt64(t, invalid, "invalid json")
+}
+
+func TestUnmarshalInt64Overflow(t *testing.T) {
+	int64Overflow := int64(math.MaxInt64)
+
+	// Max int64 should decode successfully
+	var i Int64
+	err := json.Unmarshal([]byte(strconv.FormatInt(int64Overflow, 10)), &i)
+	maybePanic(err)
+
+	// One past max int64 doesn't fit and must be rejected.
+	err = json.Unmarshal([]byte("9223372036854775808"), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows int64")
+	}
+}
+
+func TestTextUnmarshalInt64(t *testing.T) {
+	var i Int64
+	err := i.UnmarshalText([]byte("100"))
+	maybePanic(err)
+	assertInt64(t, i, "UnmarshalText() int64")
+
+	var blank Int64
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullInt64(t, blank, "UnmarshalText() empty int64")
+
+	var zero Int64
+	err = zero.UnmarshalText([]byte("0"))
+	maybePanic(err)
+	assertNullInt64(t, zero, "UnmarshalText() zero int64")
+
+	var null Int64
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullInt64(t, null, `UnmarshalText() "null"`)
+
+	var invalid Int64
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalInt64(t *testing.T) {
+	i := Int64From(100)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "100", "non-empty json marshal")
+
+	null := NewInt64(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal")
+}
+
+func TestMarshalInt64Text(t *testing.T) {
+	i := Int64From(100)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "100", "non-empty text marshal")
+
+	null := NewInt64(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null text marshal")
+}
+
+func TestInt64Pointer(t *testing.T) {
+	i := Int64From(100)
+	ptr := i.Ptr()
+	if *ptr != 100 {
+		t.Errorf("bad %s int64: %#v ≠ %d\n", "pointer", ptr, 100)
+	}
+
+	null := NewInt64(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int64: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestInt64IsZero(t *testing.T) {
+	i := Int64From(100)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewInt64(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewInt64(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestInt64SetValid(t *testing.T) {
+	change := NewInt64(0, false)
+	assertNullInt64(t, change, "SetValid()")
+	change.SetValid(100)
+	assertInt64(t, change, "SetValid()")
+}
+
+func TestInt64Scan(t *testing.T) {
+	var i Int64
+	err := i.Scan(int64(100))
+	maybePanic(err)
+	assertInt64(t, i, "scanned int64")
+
+	var null Int64
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt64(t, null, "scanned null")
+}
+
+func TestInt64ScanOverflow(t *testing.T) {
+	var i Int64
+	err := i.Scan(int64(math.MaxInt64))
+	maybePanic(err)
+
+	// An int64 source always fits, so this only checks that the maximum
+	// value is accepted.
+}
+
+func TestInt64Value(t *testing.T) {
+	v, err := Int64From(100).Value()
+	maybePanic(err)
+	if v != int64(100) {
+		t.Errorf("bad Value(): %#v is not an int64 of 100", v)
+	}
+
+	// a null Int64 still has to produce a valid driver.Value, so it comes
+	// back as the zero value rather than nil.
+	v, err = NewInt64(0, false).Value()
+	maybePanic(err)
+	if v != int64(0) {
+		t.Errorf("bad Value(): %#v is not an int64 of 0", v)
+	}
+}
+
+func TestInt64ValueOrZero(t *testing.T) {
+	valid := NewInt64(100, true)
+	if valid.ValueOrZero() != 100 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewInt64(100, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestInt64Equal(t *testing.T) {
+	int1 := NewInt64(10, false)
+	int2 := NewInt64(10, false)
+	assertInt64EqualIsTrue(t, int1, int2)
+
+	int1 = NewInt64(10, false)
+	int2 = NewInt64(20, false)
+	assertInt64EqualIsTrue(t, int1, int2)
+
+	int1 = NewInt64(10, true)
+	int2 = NewInt64(10, true)
+	assertInt64EqualIsTrue(t, int1, int2)
+
+	int1 = NewInt64(0, true)
+	int2 = NewInt64(0, false)
+	assertInt64EqualIsTrue(t, int1, int2)
+
+	int1 = NewInt64(10, true)
+	int2 = NewInt64(20, true)
+	assertInt64EqualIsFalse(t, int1, int2)
+}
+
+func assertInt64(t *testing.T, i Int64, from string) {
+	if i.ValueOrZero() != 100 {
+		t.Errorf("bad %s int64: %v ≠ %d\n", from, i.ValueOrZero(), 100)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt64(t *testing.T, i Int64, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertInt64EqualIsTrue(t *testing.T, a, b Int64) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Int64{%v, Valid:%t} and Int64{%v, Valid:%t} should return true", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func assertInt64EqualIsFalse(t *testing.T, a, b Int64) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Int64{%v, Valid:%t} and Int64{%v, Valid:%t} should return false", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func TestInt64UnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader(bytes.Join([][]byte{int64JSON, int64StringJSON, nullJSON}, []byte(" "))))
+
+	var a Int64
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertInt64(t, a, "UnmarshalNext() number")
+
+	var b Int64
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertInt64(t, b, "UnmarshalNext() string")
+
+	var c Int64
+	if err := c.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertNullInt64(t, c, "UnmarshalNext() null")
+}
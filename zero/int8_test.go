@@ -0,0 +1,294 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	int8JSON       = []byte(`100`)
+	int8StringJSON = []byte(`"100"`)
+	int8ZeroJSON   = []byte(`0`)
+)
+
+func TestInt8From(t *testing.T) {
+	i := Int8From(100)
+	assertInt8(t, i, "Int8From()")
+
+	zero := Int8From(0)
+	if zero.Valid {
+		t.Error("Int8From(0)", "is valid, but should be invalid")
+	}
+}
+
+func TestInt8FromPtr(t *testing.T) {
+	n := int8(100)
+	iptr := &n
+	i := Int8FromPtr(iptr)
+	assertInt8(t, i, "Int8FromPtr()")
+
+	null := Int8FromPtr(nil)
+	assertNullInt8(t, null, "Int8FromPtr(nil)")
+}
+
+func TestUnmarshalInt8(t *testing.T) {
+	var i Int8
+	err := json.Unmarshal(int8JSON, &i)
+	maybePanic(err)
+	assertInt8(t, i, "int8 json")
+
+	var si Int8
+	err = json.Unmarshal(int8StringJSON, &si)
+	maybePanic(err)
+	assertInt8(t, si, "int8 string json")
+
+	var zero Int8
+	err = json.Unmarshal(int8ZeroJSON, &zero)
+	maybePanic(err)
+	assertNullInt8(t, zero, "zero json")
+
+	var null Int8
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullInt8(t, null, "null json")
+
+	var badType Int8
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullInt8(t, badType, "wrong type json")
+
+	var invalid Int8
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullInt8(t, invalid, "invalid json")
+}
+
+func TestUnmarshalInt8Overflow(t *testing.T) {
+	overflow := int64(math.MaxInt8) + 1
+
+	var i Int8
+	err := json.Unmarshal([]byte(strconv.FormatInt(overflow, 10)), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows int8")
+	}
+}
+
+func TestTextUnmarshalInt8(t *testing.T) {
+	var i Int8
+	err := i.UnmarshalText([]byte("100"))
+	maybePanic(err)
+	assertInt8(t, i, "UnmarshalText() int8")
+
+	var blank Int8
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullInt8(t, blank, "UnmarshalText() empty int8")
+
+	var zero Int8
+	err = zero.UnmarshalText([]byte("0"))
+	maybePanic(err)
+	assertNullInt8(t, zero, "UnmarshalText() zero int8")
+
+	var null Int8
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullInt8(t, null, `UnmarshalText() "null"`)
+
+	var invalid Int8
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalInt8(t *testing.T) {
+	i := Int8From(100)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "100", "non-empty json marshal")
+
+	null := NewInt8(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal")
+}
+
+func TestMarshalInt8Text(t *testing.T) {
+	i := Int8From(100)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "100", "non-empty text marshal")
+
+	null := NewInt8(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null text marshal")
+}
+
+func TestInt8Pointer(t *testing.T) {
+	i := Int8From(100)
+	ptr := i.Ptr()
+	if *ptr != 100 {
+		t.Errorf("bad %s int8: %#v ≠ %d\n", "pointer", ptr, 100)
+	}
+
+	null := NewInt8(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s int8: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestInt8IsZero(t *testing.T) {
+	i := Int8From(100)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewInt8(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewInt8(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestInt8SetValid(t *testing.T) {
+	change := NewInt8(0, false)
+	assertNullInt8(t, change, "SetValid()")
+	change.SetValid(100)
+	assertInt8(t, change, "SetValid()")
+}
+
+func TestInt8Scan(t *testing.T) {
+	var i Int8
+	err := i.Scan(int64(100))
+	maybePanic(err)
+	assertInt8(t, i, "scanned int8")
+
+	var null Int8
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullInt8(t, null, "scanned null")
+}
+
+func TestInt8ScanOverflow(t *testing.T) {
+	var i Int8
+	err := i.Scan(int64(math.MaxInt8) + 1)
+	if err == nil {
+		panic("err should be present; scanned value overflows int8")
+	}
+}
+
+func TestInt8Value(t *testing.T) {
+	v, err := Int8From(100).Value()
+	maybePanic(err)
+	if v != int64(100) {
+		t.Errorf("bad Value(): %#v is not an int64 of 100", v)
+	}
+
+	// a null Int8 still has to produce a valid driver.Value, so it comes
+	// back as the zero value rather than nil.
+	v, err = NewInt8(0, false).Value()
+	maybePanic(err)
+	if v != int64(0) {
+		t.Errorf("bad Value(): %#v is not an int64 of 0", v)
+	}
+}
+
+func TestInt8ValueOrZero(t *testing.T) {
+	valid := NewInt8(100, true)
+	if valid.ValueOrZero() != 100 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewInt8(100, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestInt8Equal(t *testing.T) {
+	int1 := NewInt8(10, false)
+	int2 := NewInt8(10, false)
+	assertInt8EqualIsTrue(t, int1, int2)
+
+	int1 = NewInt8(10, false)
+	int2 = NewInt8(20, false)
+	assertInt8EqualIsTrue(t, int1, int2)
+
+	int1 = NewInt8(10, true)
+	int2 = NewInt8(10, true)
+	assertInt8EqualIsTrue(t, int1, int2)
+
+	int1 = NewInt8(0, true)
+	int2 = NewInt8(0, false)
+	assertInt8EqualIsTrue(t, int1, int2)
+
+	int1 = NewInt8(10, true)
+	int2 = NewInt8(20, true)
+	assertInt8EqualIsFalse(t, int1, int2)
+}
+
+func assertInt8(t *testing.T, i Int8, from string) {
+	if i.ValueOrZero() != 100 {
+		t.Errorf("bad %s int8: %v ≠ %d\n", from, i.ValueOrZero(), 100)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullInt8(t *testing.T, i Int8, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertInt8EqualIsTrue(t *testing.T, a, b Int8) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Int8{%v, Valid:%t} and Int8{%v, Valid:%t} should return true", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func assertInt8EqualIsFalse(t *testing.T, a, b Int8) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Int8{%v, Valid:%t} and Int8{%v, Valid:%t} should return false", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func TestInt8UnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader(bytes.Join([][]byte{int8JSON, int8StringJSON, nullJSON}, []byte(" "))))
+
+	var a Int8
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertInt8(t, a, "UnmarshalNext() number")
+
+	var b Int8
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertInt8(t, b, "UnmarshalNext() string")
+
+	var c Int8
+	if err := c.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertNullInt8(t, c, "UnmarshalNext() null")
+}
@@ -2,28 +2,30 @@ package zero
 
 import (
 	"bytes"
-	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
+
+	"github.com/toby1991/null/nulljson"
 )
 
-// Uint is a nullable uint64.
+// Uint is a nullable uint64, implemented as a thin wrapper over
+// Variable[uint64].
 // JSON marshals to zero if null.
 // Considered null to SQL if zero.
+//
+// UnmarshalJSON and Scan are overridden here for uint64-specific input (the
+// struct-shaped JSON form below, and a []byte/string Scan source) that
+// Variable has no way to express generically. ValueOrZero, SetValid, and
+// Value are inherited from Variable[uint64].
 type Uint struct {
-	sql.NullString
+	Variable[uint64]
 }
 
 // NewUint creates a new Uint
 func NewUint(i uint64, valid bool) Uint {
-	return Uint{
-		NullString: sql.NullString{
-			String: strconv.FormatUint(i, 10),
-			Valid:  valid,
-		},
-	}
+	return Uint{Variable: NewVariable(i, valid)}
 }
 
 // UintFrom creates a new Uint that will be null if zero.
@@ -36,22 +38,16 @@ func UintFromPtr(i *uint64) Uint {
 	if i == nil {
 		return NewUint(0, false)
 	}
-	n := NewUint(*i, true)
-	return n
-}
-
-// ValueOrZero returns the inner value if valid, otherwise zero.
-func (i Uint) ValueOrZero() uint64 {
-	if !i.Valid {
-		return 0
-	}
-	parseUint, _ := strconv.ParseUint(i.String, 10, 64)
-	return parseUint
+	return NewUint(*i, true)
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Uint.
+//
+// It also accepts the struct-shaped {"Uint64":123,"Valid":true} form that
+// this type's own MarshalJSON doesn't produce, matching how sql.NullInt64
+// round-trips through encoding/json.
 func (i *Uint) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, nullBytes) {
 		i.Valid = false
@@ -63,105 +59,91 @@ func (i *Uint) UnmarshalJSON(data []byte) error {
 		var typeError *json.UnmarshalTypeError
 		if errors.As(err, &typeError) {
 			// special case: accept string input
-			if typeError.Value != "string" {
-				return fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
-			}
-			var str string
-			if err := json.Unmarshal(data, &str); err != nil {
-				return fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
+			if typeError.Value == "string" {
+				var str string
+				if err := json.Unmarshal(data, &str); err != nil {
+					return fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
+				}
+				n, err := strconv.ParseUint(str, 10, 64)
+				if err != nil {
+					return fmt.Errorf("zero: couldn't convert string to int: %w", err)
+				}
+				i.val = n
+				i.Valid = n != 0
+				return nil
 			}
-			n, err := strconv.ParseUint(str, 10, 64)
-			if err != nil {
-				return fmt.Errorf("zero: couldn't convert string to int: %w", err)
+			// special case: accept the {"Uint64":...,"Valid":...} struct form
+			if typeError.Value == "object" {
+				var aux struct {
+					Uint64 uint64
+					Valid  bool
+				}
+				if err := json.Unmarshal(data, &aux); err != nil {
+					return fmt.Errorf("zero: couldn't unmarshal struct form: %w", err)
+				}
+				i.val = aux.Uint64
+				i.Valid = aux.Valid && aux.Uint64 != 0
+				return nil
 			}
-			i.String = strconv.FormatUint(n, 10)
-			i.Valid = n != 0
-			return nil
+			return fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
 		}
 		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
 	}
 
-	i.String = strconv.FormatUint(_n, 10)
+	i.val = _n
 	i.Valid = _n != 0
 	return nil
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Uint if the input is a blank, or zero.
-// It will return an error if the input is not an integer, blank, or "null".
-func (i *Uint) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
-	n, err := strconv.ParseUint(str, 10, 64)
-	if err != nil {
-		return fmt.Errorf("null: couldn't convert string to int: %w", err)
-	}
-	i.String = strconv.FormatUint(n, 10)
-	i.Valid = n != 0
-	return err
-}
-
-// MarshalJSON implements json.Marshaler.
-// It will encode 0 if this Uint is null.
-func (i Uint) MarshalJSON() ([]byte, error) {
-	parseUint, err := strconv.ParseUint(i.String, 10, 64)
-	if err != nil {
-		return nil, err
-	}
-	n := parseUint
-	if !i.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatUint(n, 10)), nil
-}
-
-// MarshalText implements encoding.TextMarshaler.
-// It will encode a zero if this Uint is null.
-func (i Uint) MarshalText() ([]byte, error) {
-	parseUint, err := strconv.ParseUint(i.String, 10, 64)
-	if err != nil {
-		return nil, err
-	}
-	n := parseUint
-	if !i.Valid {
-		n = 0
-	}
-	return []byte(strconv.FormatUint(n, 10)), nil
-}
-
-// SetValid changes this Uint's value and also sets it to be non-null.
-func (i *Uint) SetValid(n uint64) {
-	i.String = strconv.FormatUint(n, 10)
-	i.Valid = true
-}
-
 // Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
 func (i Uint) Ptr() *uint64 {
 	if !i.Valid {
 		return nil
 	}
+	return &i.val
+}
 
-	// todo: may cause ptr error
-	parseUint, err := strconv.ParseUint(i.String, 10, 64)
-	if err != nil {
-		return nil
-	}
-	return &parseUint
+// Equal returns true if both ints have the same value or are both either null or zero.
+func (i Uint) Equal(other Uint) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
 }
 
-// IsZero returns true for null or zero Uints, for future omitempty support (Go 1.4?)
-func (i Uint) IsZero() bool {
-	n, err := strconv.ParseUint(i.String, 10, 64)
-	if err != nil {
-		return true
+// Scan implements the sql.Scanner interface.
+func (i *Uint) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("zero: %d overflows uint64", v)
+		}
+		i.val = uint64(v)
+	case uint64:
+		i.val = v
+	case []byte:
+		n, err := strconv.ParseUint(string(v), 10, 64)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan []byte into uint64: %w", err)
+		}
+		i.val = n
+	case string:
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan string into uint64: %w", err)
+		}
+		i.val = n
+	default:
+		return fmt.Errorf("zero: cannot scan type %T into zero.Uint: %v", value, value)
 	}
-	return !i.Valid || n == 0
+	i.Valid = i.val != 0
+	return nil
 }
 
-// Equal returns true if both ints have the same value or are both either null or zero.
-func (i Uint) Equal(other Uint) bool {
-	return i.ValueOrZero() == other.ValueOrZero()
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Uint) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
 }
@@ -0,0 +1,294 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	uint16JSON       = []byte(`100`)
+	uint16StringJSON = []byte(`"100"`)
+	uint16ZeroJSON   = []byte(`0`)
+)
+
+func TestUint16From(t *testing.T) {
+	i := Uint16From(100)
+	assertUint16(t, i, "Uint16From()")
+
+	zero := Uint16From(0)
+	if zero.Valid {
+		t.Error("Uint16From(0)", "is valid, but should be invalid")
+	}
+}
+
+func TestUint16FromPtr(t *testing.T) {
+	n := uint16(100)
+	iptr := &n
+	i := Uint16FromPtr(iptr)
+	assertUint16(t, i, "Uint16FromPtr()")
+
+	null := Uint16FromPtr(nil)
+	assertNullUint16(t, null, "Uint16FromPtr(nil)")
+}
+
+func TestUnmarshalUint16(t *testing.T) {
+	var i Uint16
+	err := json.Unmarshal(uint16JSON, &i)
+	maybePanic(err)
+	assertUint16(t, i, "uint16 json")
+
+	var si Uint16
+	err = json.Unmarshal(uint16StringJSON, &si)
+	maybePanic(err)
+	assertUint16(t, si, "uint16 string json")
+
+	var zero Uint16
+	err = json.Unmarshal(uint16ZeroJSON, &zero)
+	maybePanic(err)
+	assertNullUint16(t, zero, "zero json")
+
+	var null Uint16
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint16(t, null, "null json")
+
+	var badType Uint16
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullUint16(t, badType, "wrong type json")
+
+	var invalid Uint16
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullUint16(t, invalid, "invalid json")
+}
+
+func TestUnmarshalUint16Overflow(t *testing.T) {
+	overflow := uint64(math.MaxUint16) + 1
+
+	var i Uint16
+	err := json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows uint16")
+	}
+}
+
+func TestTextUnmarshalUint16(t *testing.T) {
+	var i Uint16
+	err := i.UnmarshalText([]byte("100"))
+	maybePanic(err)
+	assertUint16(t, i, "UnmarshalText() uint16")
+
+	var blank Uint16
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullUint16(t, blank, "UnmarshalText() empty uint16")
+
+	var zero Uint16
+	err = zero.UnmarshalText([]byte("0"))
+	maybePanic(err)
+	assertNullUint16(t, zero, "UnmarshalText() zero uint16")
+
+	var null Uint16
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullUint16(t, null, `UnmarshalText() "null"`)
+
+	var invalid Uint16
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalUint16(t *testing.T) {
+	i := Uint16From(100)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "100", "non-empty json marshal")
+
+	null := NewUint16(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal")
+}
+
+func TestMarshalUint16Text(t *testing.T) {
+	i := Uint16From(100)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "100", "non-empty text marshal")
+
+	null := NewUint16(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null text marshal")
+}
+
+func TestUint16Pointer(t *testing.T) {
+	i := Uint16From(100)
+	ptr := i.Ptr()
+	if *ptr != 100 {
+		t.Errorf("bad %s uint16: %#v ≠ %d\n", "pointer", ptr, 100)
+	}
+
+	null := NewUint16(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint16: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUint16IsZero(t *testing.T) {
+	i := Uint16From(100)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewUint16(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewUint16(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestUint16SetValid(t *testing.T) {
+	change := NewUint16(0, false)
+	assertNullUint16(t, change, "SetValid()")
+	change.SetValid(100)
+	assertUint16(t, change, "SetValid()")
+}
+
+func TestUint16Scan(t *testing.T) {
+	var i Uint16
+	err := i.Scan(int64(100))
+	maybePanic(err)
+	assertUint16(t, i, "scanned uint16")
+
+	var null Uint16
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint16(t, null, "scanned null")
+}
+
+func TestUint16ScanOverflow(t *testing.T) {
+	var i Uint16
+	err := i.Scan(int64(math.MaxUint16) + 1)
+	if err == nil {
+		panic("err should be present; scanned value overflows uint16")
+	}
+}
+
+func TestUint16Value(t *testing.T) {
+	v, err := Uint16From(100).Value()
+	maybePanic(err)
+	if v != int64(100) {
+		t.Errorf("bad Value(): %#v is not an int64 of 100", v)
+	}
+
+	// a null Uint16 still has to produce a valid driver.Value, so it comes
+	// back as the zero value rather than nil.
+	v, err = NewUint16(0, false).Value()
+	maybePanic(err)
+	if v != int64(0) {
+		t.Errorf("bad Value(): %#v is not an int64 of 0", v)
+	}
+}
+
+func TestUint16ValueOrZero(t *testing.T) {
+	valid := NewUint16(100, true)
+	if valid.ValueOrZero() != 100 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUint16(100, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestUint16Equal(t *testing.T) {
+	int1 := NewUint16(10, false)
+	int2 := NewUint16(10, false)
+	assertUint16EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint16(10, false)
+	int2 = NewUint16(20, false)
+	assertUint16EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint16(10, true)
+	int2 = NewUint16(10, true)
+	assertUint16EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint16(0, true)
+	int2 = NewUint16(0, false)
+	assertUint16EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint16(10, true)
+	int2 = NewUint16(20, true)
+	assertUint16EqualIsFalse(t, int1, int2)
+}
+
+func assertUint16(t *testing.T, i Uint16, from string) {
+	if i.ValueOrZero() != 100 {
+		t.Errorf("bad %s uint16: %v ≠ %d\n", from, i.ValueOrZero(), 100)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint16(t *testing.T, i Uint16, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertUint16EqualIsTrue(t *testing.T, a, b Uint16) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Uint16{%v, Valid:%t} and Uint16{%v, Valid:%t} should return true", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func assertUint16EqualIsFalse(t *testing.T, a, b Uint16) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Uint16{%v, Valid:%t} and Uint16{%v, Valid:%t} should return false", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func TestUint16UnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader(bytes.Join([][]byte{uint16JSON, uint16StringJSON, nullJSON}, []byte(" "))))
+
+	var a Uint16
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertUint16(t, a, "UnmarshalNext() number")
+
+	var b Uint16
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertUint16(t, b, "UnmarshalNext() string")
+
+	var c Uint16
+	if err := c.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertNullUint16(t, c, "UnmarshalNext() null")
+}
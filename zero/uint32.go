@@ -0,0 +1,138 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/toby1991/null/nulljson"
+)
+
+// Uint32 is a nullable uint32, implemented as a thin wrapper over
+// Variable[uint32].
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+//
+// UnmarshalJSON and Scan are overridden here for uint32-specific overflow
+// checking and string-accepting input that Variable has no way to express
+// generically. ValueOrZero, SetValid, MarshalText, UnmarshalText, and Value
+// are inherited from Variable[uint32].
+type Uint32 struct {
+	Variable[uint32]
+}
+
+// NewUint32 creates a new Uint32
+func NewUint32(i uint32, valid bool) Uint32 {
+	return Uint32{Variable: NewVariable(i, valid)}
+}
+
+// Uint32From creates a new Uint32 that will be null if zero.
+func Uint32From(i uint32) Uint32 {
+	return NewUint32(i, i != 0)
+}
+
+// Uint32FromPtr creates a new Uint32 that be null if i is nil.
+func Uint32FromPtr(i *uint32) Uint32 {
+	if i == nil {
+		return NewUint32(0, false)
+	}
+	return NewUint32(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will be considered a null Uint32.
+func (i *Uint32) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	var _n uint64
+	if err := json.Unmarshal(data, &_n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" {
+				return fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseUint(str, 10, 32)
+			if err != nil {
+				return fmt.Errorf("zero: couldn't convert string to uint32: %w", err)
+			}
+			i.val = uint32(n)
+			i.Valid = n != 0
+			return nil
+		}
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if _n > math.MaxUint32 {
+		return fmt.Errorf("zero: %d overflows uint32", _n)
+	}
+	i.val = uint32(_n)
+	i.Valid = _n != 0
+	return nil
+}
+
+// Ptr returns a pointer to this Uint32's value, or a nil pointer if this Uint32 is null.
+func (i Uint32) Ptr() *uint32 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.val
+}
+
+// Equal returns true if both uint32s have the same value or are both either null or zero.
+func (i Uint32) Equal(other Uint32) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Uint32) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < 0 || uint64(v) > math.MaxUint32 {
+			return fmt.Errorf("zero: %d overflows uint32", v)
+		}
+		i.val = uint32(v)
+	case uint64:
+		if v > math.MaxUint32 {
+			return fmt.Errorf("zero: %d overflows uint32", v)
+		}
+		i.val = uint32(v)
+	case []byte:
+		n, err := strconv.ParseUint(string(v), 10, 32)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan []byte into uint32: %w", err)
+		}
+		i.val = uint32(n)
+	case string:
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan string into uint32: %w", err)
+		}
+		i.val = uint32(n)
+	default:
+		return fmt.Errorf("zero: cannot scan type %T into zero.Uint32: %v", value, value)
+	}
+	i.Valid = i.val != 0
+	return nil
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Uint32) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
+}
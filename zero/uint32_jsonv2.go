@@ -0,0 +1,21 @@
+//go:build goexperiment.jsonv2
+
+package zero
+
+import (
+	"encoding/json/jsontext"
+
+	"github.com/toby1991/null/nulljsonv2"
+)
+
+// MarshalJSONTo implements json/v2's MarshalerTo. Combined with this type's
+// IsZero method, it lets a struct field tagged `json:",omitzero"` be
+// omitted entirely when this Uint32 is null or zero.
+func (i Uint32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return nulljsonv2.MarshalJSONTo(enc, i)
+}
+
+// UnmarshalJSONFrom implements json/v2's UnmarshalerFrom.
+func (i *Uint32) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	return nulljsonv2.UnmarshalJSONFrom(dec, i)
+}
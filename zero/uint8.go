@@ -0,0 +1,138 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/toby1991/null/nulljson"
+)
+
+// Uint8 is a nullable uint8, implemented as a thin wrapper over
+// Variable[uint8].
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+//
+// UnmarshalJSON and Scan are overridden here for uint8-specific overflow
+// checking and string-accepting input that Variable has no way to express
+// generically. ValueOrZero, SetValid, MarshalText, UnmarshalText, and Value
+// are inherited from Variable[uint8].
+type Uint8 struct {
+	Variable[uint8]
+}
+
+// NewUint8 creates a new Uint8
+func NewUint8(i uint8, valid bool) Uint8 {
+	return Uint8{Variable: NewVariable(i, valid)}
+}
+
+// Uint8From creates a new Uint8 that will be null if zero.
+func Uint8From(i uint8) Uint8 {
+	return NewUint8(i, i != 0)
+}
+
+// Uint8FromPtr creates a new Uint8 that be null if i is nil.
+func Uint8FromPtr(i *uint8) Uint8 {
+	if i == nil {
+		return NewUint8(0, false)
+	}
+	return NewUint8(*i, true)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string, and null input.
+// 0 will be considered a null Uint8.
+func (i *Uint8) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		i.Valid = false
+		return nil
+	}
+
+	var _n uint64
+	if err := json.Unmarshal(data, &_n); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) {
+			// special case: accept string input
+			if typeError.Value != "string" {
+				return fmt.Errorf("zero: JSON input is invalid type (need int or string): %w", err)
+			}
+			var str string
+			if err := json.Unmarshal(data, &str); err != nil {
+				return fmt.Errorf("zero: couldn't unmarshal number string: %w", err)
+			}
+			n, err := strconv.ParseUint(str, 10, 8)
+			if err != nil {
+				return fmt.Errorf("zero: couldn't convert string to uint8: %w", err)
+			}
+			i.val = uint8(n)
+			i.Valid = n != 0
+			return nil
+		}
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	if _n > math.MaxUint8 {
+		return fmt.Errorf("zero: %d overflows uint8", _n)
+	}
+	i.val = uint8(_n)
+	i.Valid = _n != 0
+	return nil
+}
+
+// Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
+func (i Uint8) Ptr() *uint8 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.val
+}
+
+// Equal returns true if both uint8s have the same value or are both either null or zero.
+func (i Uint8) Equal(other Uint8) bool {
+	return i.ValueOrZero() == other.ValueOrZero()
+}
+
+// Scan implements the sql.Scanner interface.
+func (i *Uint8) Scan(value interface{}) error {
+	if value == nil {
+		i.val, i.Valid = 0, false
+		return nil
+	}
+	switch v := value.(type) {
+	case int64:
+		if v < 0 || uint64(v) > math.MaxUint8 {
+			return fmt.Errorf("zero: %d overflows uint8", v)
+		}
+		i.val = uint8(v)
+	case uint64:
+		if v > math.MaxUint8 {
+			return fmt.Errorf("zero: %d overflows uint8", v)
+		}
+		i.val = uint8(v)
+	case []byte:
+		n, err := strconv.ParseUint(string(v), 10, 8)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan []byte into uint8: %w", err)
+		}
+		i.val = uint8(n)
+	case string:
+		n, err := strconv.ParseUint(v, 10, 8)
+		if err != nil {
+			return fmt.Errorf("zero: couldn't scan string into uint8: %w", err)
+		}
+		i.val = uint8(n)
+	default:
+		return fmt.Errorf("zero: cannot scan type %T into zero.Uint8: %v", value, value)
+	}
+	i.Valid = i.val != 0
+	return nil
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (i *Uint8) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, i)
+}
@@ -0,0 +1,294 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	uint8JSON       = []byte(`100`)
+	uint8StringJSON = []byte(`"100"`)
+	uint8ZeroJSON   = []byte(`0`)
+)
+
+func TestUint8From(t *testing.T) {
+	i := Uint8From(100)
+	assertUint8(t, i, "Uint8From()")
+
+	zero := Uint8From(0)
+	if zero.Valid {
+		t.Error("Uint8From(0)", "is valid, but should be invalid")
+	}
+}
+
+func TestUint8FromPtr(t *testing.T) {
+	n := uint8(100)
+	iptr := &n
+	i := Uint8FromPtr(iptr)
+	assertUint8(t, i, "Uint8FromPtr()")
+
+	null := Uint8FromPtr(nil)
+	assertNullUint8(t, null, "Uint8FromPtr(nil)")
+}
+
+func TestUnmarshalUint8(t *testing.T) {
+	var i Uint8
+	err := json.Unmarshal(uint8JSON, &i)
+	maybePanic(err)
+	assertUint8(t, i, "uint8 json")
+
+	var si Uint8
+	err = json.Unmarshal(uint8StringJSON, &si)
+	maybePanic(err)
+	assertUint8(t, si, "uint8 string json")
+
+	var zero Uint8
+	err = json.Unmarshal(uint8ZeroJSON, &zero)
+	maybePanic(err)
+	assertNullUint8(t, zero, "zero json")
+
+	var null Uint8
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint8(t, null, "null json")
+
+	var badType Uint8
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullUint8(t, badType, "wrong type json")
+
+	var invalid Uint8
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullUint8(t, invalid, "invalid json")
+}
+
+func TestUnmarshalUint8Overflow(t *testing.T) {
+	overflow := uint64(math.MaxUint8) + 1
+
+	var i Uint8
+	err := json.Unmarshal([]byte(strconv.FormatUint(overflow, 10)), &i)
+	if err == nil {
+		panic("err should be present; decoded value overflows uint8")
+	}
+}
+
+func TestTextUnmarshalUint8(t *testing.T) {
+	var i Uint8
+	err := i.UnmarshalText([]byte("100"))
+	maybePanic(err)
+	assertUint8(t, i, "UnmarshalText() uint8")
+
+	var blank Uint8
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullUint8(t, blank, "UnmarshalText() empty uint8")
+
+	var zero Uint8
+	err = zero.UnmarshalText([]byte("0"))
+	maybePanic(err)
+	assertNullUint8(t, zero, "UnmarshalText() zero uint8")
+
+	var null Uint8
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullUint8(t, null, `UnmarshalText() "null"`)
+
+	var invalid Uint8
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalUint8(t *testing.T) {
+	i := Uint8From(100)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "100", "non-empty json marshal")
+
+	null := NewUint8(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal")
+}
+
+func TestMarshalUint8Text(t *testing.T) {
+	i := Uint8From(100)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "100", "non-empty text marshal")
+
+	null := NewUint8(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null text marshal")
+}
+
+func TestUint8Pointer(t *testing.T) {
+	i := Uint8From(100)
+	ptr := i.Ptr()
+	if *ptr != 100 {
+		t.Errorf("bad %s uint8: %#v ≠ %d\n", "pointer", ptr, 100)
+	}
+
+	null := NewUint8(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint8: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUint8IsZero(t *testing.T) {
+	i := Uint8From(100)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewUint8(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewUint8(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestUint8SetValid(t *testing.T) {
+	change := NewUint8(0, false)
+	assertNullUint8(t, change, "SetValid()")
+	change.SetValid(100)
+	assertUint8(t, change, "SetValid()")
+}
+
+func TestUint8Scan(t *testing.T) {
+	var i Uint8
+	err := i.Scan(int64(100))
+	maybePanic(err)
+	assertUint8(t, i, "scanned uint8")
+
+	var null Uint8
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint8(t, null, "scanned null")
+}
+
+func TestUint8ScanOverflow(t *testing.T) {
+	var i Uint8
+	err := i.Scan(int64(math.MaxUint8) + 1)
+	if err == nil {
+		panic("err should be present; scanned value overflows uint8")
+	}
+}
+
+func TestUint8Value(t *testing.T) {
+	v, err := Uint8From(100).Value()
+	maybePanic(err)
+	if v != int64(100) {
+		t.Errorf("bad Value(): %#v is not an int64 of 100", v)
+	}
+
+	// a null Uint8 still has to produce a valid driver.Value, so it comes
+	// back as the zero value rather than nil.
+	v, err = NewUint8(0, false).Value()
+	maybePanic(err)
+	if v != int64(0) {
+		t.Errorf("bad Value(): %#v is not an int64 of 0", v)
+	}
+}
+
+func TestUint8ValueOrZero(t *testing.T) {
+	valid := NewUint8(100, true)
+	if valid.ValueOrZero() != 100 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUint8(100, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestUint8Equal(t *testing.T) {
+	int1 := NewUint8(10, false)
+	int2 := NewUint8(10, false)
+	assertUint8EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint8(10, false)
+	int2 = NewUint8(20, false)
+	assertUint8EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint8(10, true)
+	int2 = NewUint8(10, true)
+	assertUint8EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint8(0, true)
+	int2 = NewUint8(0, false)
+	assertUint8EqualIsTrue(t, int1, int2)
+
+	int1 = NewUint8(10, true)
+	int2 = NewUint8(20, true)
+	assertUint8EqualIsFalse(t, int1, int2)
+}
+
+func assertUint8(t *testing.T, i Uint8, from string) {
+	if i.ValueOrZero() != 100 {
+		t.Errorf("bad %s uint8: %v ≠ %d\n", from, i.ValueOrZero(), 100)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint8(t *testing.T, i Uint8, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertUint8EqualIsTrue(t *testing.T, a, b Uint8) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Uint8{%v, Valid:%t} and Uint8{%v, Valid:%t} should return true", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func assertUint8EqualIsFalse(t *testing.T, a, b Uint8) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Uint8{%v, Valid:%t} and Uint8{%v, Valid:%t} should return false", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func TestUint8UnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader(bytes.Join([][]byte{uint8JSON, uint8StringJSON, nullJSON}, []byte(" "))))
+
+	var a Uint8
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertUint8(t, a, "UnmarshalNext() number")
+
+	var b Uint8
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertUint8(t, b, "UnmarshalNext() string")
+
+	var c Uint8
+	if err := c.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertNullUint8(t, c, "UnmarshalNext() null")
+}
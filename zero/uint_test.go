@@ -0,0 +1,313 @@
+package zero
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+var (
+	uintJSON       = []byte(`12345`)
+	uintStringJSON = []byte(`"12345"`)
+	nullUintJSON   = []byte(`{"Uint64":12345,"Valid":true}`)
+)
+
+func TestUintFrom(t *testing.T) {
+	i := UintFrom(12345)
+	assertUint(t, i, "UintFrom()")
+
+	zero := UintFrom(0)
+	if zero.Valid {
+		t.Error("UintFrom(0)", "is valid, but should be invalid")
+	}
+}
+
+func TestUintFromPtr(t *testing.T) {
+	n := uint64(12345)
+	iptr := &n
+	i := UintFromPtr(iptr)
+	assertUint(t, i, "UintFromPtr()")
+
+	null := UintFromPtr(nil)
+	assertNullUint(t, null, "UintFromPtr(nil)")
+}
+
+func TestUnmarshalUint(t *testing.T) {
+	var i Uint
+	err := json.Unmarshal(uintJSON, &i)
+	maybePanic(err)
+	assertUint(t, i, "uint json")
+
+	var si Uint
+	err = json.Unmarshal(uintStringJSON, &si)
+	maybePanic(err)
+	assertUint(t, si, "uint string json")
+
+	var ni Uint
+	err = json.Unmarshal(nullUintJSON, &ni)
+	maybePanic(err)
+	assertUint(t, ni, "struct-form json")
+
+	var bi Uint
+	err = json.Unmarshal(floatBlankJSON, &bi)
+	if err == nil {
+		panic("err should not be nill")
+	}
+
+	var zero Uint
+	err = json.Unmarshal([]byte(`0`), &zero)
+	maybePanic(err)
+	assertNullUint(t, zero, "zero json")
+
+	var null Uint
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	assertNullUint(t, null, "null json")
+
+	var badType Uint
+	err = json.Unmarshal(boolJSON, &badType)
+	if err == nil {
+		panic("err should not be nil")
+	}
+	assertNullUint(t, badType, "wrong type json")
+
+	var invalid Uint
+	err = invalid.UnmarshalJSON(invalidJSON)
+	var syntaxError *json.SyntaxError
+	if !errors.As(err, &syntaxError) {
+		t.Errorf("expected wrapped json.SyntaxError, not %T", err)
+	}
+	assertNullUint(t, invalid, "invalid json")
+}
+
+func TestUnmarshalNonUintegerNumber(t *testing.T) {
+	var i Uint
+	err := json.Unmarshal(floatJSON, &i)
+	if err == nil {
+		panic("err should be present; non-integer number coerced to uint")
+	}
+}
+
+func TestUnmarshalUint64Overflow(t *testing.T) {
+	uint64Overflow := uint64(math.MaxUint64)
+
+	// Max uint64 should decode successfully
+	var i Uint
+	err := json.Unmarshal([]byte(strconv.FormatUint(uint64Overflow, 10)), &i)
+	maybePanic(err)
+}
+
+func TestTextUnmarshalUint(t *testing.T) {
+	var i Uint
+	err := i.UnmarshalText([]byte("12345"))
+	maybePanic(err)
+	assertUint(t, i, "UnmarshalText() uint")
+
+	var blank Uint
+	err = blank.UnmarshalText([]byte(""))
+	maybePanic(err)
+	assertNullUint(t, blank, "UnmarshalText() empty uint")
+
+	var zero Uint
+	err = zero.UnmarshalText([]byte("0"))
+	maybePanic(err)
+	assertNullUint(t, zero, "UnmarshalText() zero uint")
+
+	var null Uint
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	assertNullUint(t, null, `UnmarshalText() "null"`)
+
+	var invalid Uint
+	err = invalid.UnmarshalText([]byte("hello world"))
+	if err == nil {
+		panic("expected error")
+	}
+}
+
+func TestMarshalUint(t *testing.T) {
+	i := UintFrom(12345)
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty json marshal")
+
+	null := NewUint(0, false)
+	data, err = json.Marshal(null)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null json marshal")
+}
+
+func TestMarshalUintText(t *testing.T) {
+	i := UintFrom(12345)
+	data, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "12345", "non-empty text marshal")
+
+	null := NewUint(0, false)
+	data, err = null.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null text marshal")
+}
+
+func TestUintPointer(t *testing.T) {
+	i := UintFrom(12345)
+	ptr := i.Ptr()
+	if *ptr != 12345 {
+		t.Errorf("bad %s uint: %#v ≠ %d\n", "pointer", ptr, 12345)
+	}
+
+	null := NewUint(0, false)
+	ptr = null.Ptr()
+	if ptr != nil {
+		t.Errorf("bad %s uint: %#v ≠ %s\n", "nil pointer", ptr, "nil")
+	}
+}
+
+func TestUintIsZero(t *testing.T) {
+	i := UintFrom(12345)
+	if i.IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+
+	null := NewUint(0, false)
+	if !null.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+
+	zero := NewUint(0, true)
+	if !zero.IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestUintSetValid(t *testing.T) {
+	change := NewUint(0, false)
+	assertNullUint(t, change, "SetValid()")
+	change.SetValid(12345)
+	assertUint(t, change, "SetValid()")
+}
+
+func TestUintScan(t *testing.T) {
+	var i Uint
+	err := i.Scan(int64(12345))
+	maybePanic(err)
+	assertUint(t, i, "scanned uint")
+
+	var null Uint
+	err = null.Scan(nil)
+	maybePanic(err)
+	assertNullUint(t, null, "scanned null")
+}
+
+func TestUintValue(t *testing.T) {
+	v, err := UintFrom(12345).Value()
+	maybePanic(err)
+	if v != int64(12345) {
+		t.Errorf("bad Value(): %#v is not an int64 of 12345", v)
+	}
+
+	// values that overflow int64 aren't a driver.Value on their own; they
+	// must come back as a string, not a bare uint64.
+	wide := NewUint(math.MaxUint64, true)
+	v, err = wide.Value()
+	maybePanic(err)
+	if v != strconv.FormatUint(math.MaxUint64, 10) {
+		t.Errorf("bad Value(): %#v ≠ %q", v, strconv.FormatUint(math.MaxUint64, 10))
+	}
+
+	// a null Uint is considered zero, so it still has to produce a valid
+	// driver.Value rather than nil.
+	v, err = NewUint(0, false).Value()
+	maybePanic(err)
+	if v != int64(0) {
+		t.Errorf("bad Value(): %#v is not an int64 of 0", v)
+	}
+}
+
+func TestUintValueOrZero(t *testing.T) {
+	valid := NewUint(12345, true)
+	if valid.ValueOrZero() != 12345 {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewUint(12345, false)
+	if invalid.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
+
+func TestUintEqual(t *testing.T) {
+	int1 := NewUint(10, false)
+	int2 := NewUint(10, false)
+	assertUintEqualIsTrue(t, int1, int2)
+
+	int1 = NewUint(10, false)
+	int2 = NewUint(20, false)
+	assertUintEqualIsTrue(t, int1, int2)
+
+	int1 = NewUint(10, true)
+	int2 = NewUint(10, true)
+	assertUintEqualIsTrue(t, int1, int2)
+
+	int1 = NewUint(0, true)
+	int2 = NewUint(0, false)
+	assertUintEqualIsTrue(t, int1, int2)
+
+	int1 = NewUint(10, true)
+	int2 = NewUint(20, true)
+	assertUintEqualIsFalse(t, int1, int2)
+}
+
+func assertUint(t *testing.T, i Uint, from string) {
+	if i.ValueOrZero() != 12345 {
+		t.Errorf("bad %s uint: %d ≠ %d\n", from, i.ValueOrZero(), 12345)
+	}
+	if !i.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
+
+func assertNullUint(t *testing.T, i Uint, from string) {
+	if i.Valid {
+		t.Error(from, "is valid, but should be invalid")
+	}
+}
+
+func assertUintEqualIsTrue(t *testing.T, a, b Uint) {
+	t.Helper()
+	if !a.Equal(b) {
+		t.Errorf("Equal() of Uint{%v, Valid:%t} and Uint{%v, Valid:%t} should return true", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func assertUintEqualIsFalse(t *testing.T, a, b Uint) {
+	t.Helper()
+	if a.Equal(b) {
+		t.Errorf("Equal() of Uint{%v, Valid:%t} and Uint{%v, Valid:%t} should return false", a.ValueOrZero(), a.Valid, b.ValueOrZero(), b.Valid)
+	}
+}
+
+func TestUintUnmarshalNext(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader(bytes.Join([][]byte{uintJSON, uintStringJSON, nullJSON}, []byte(" "))))
+
+	var a Uint
+	if err := a.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertUint(t, a, "UnmarshalNext() number")
+
+	var b Uint
+	if err := b.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertUint(t, b, "UnmarshalNext() string")
+
+	var c Uint
+	if err := c.UnmarshalNext(dec); err != nil {
+		t.Fatal(err)
+	}
+	assertNullUint(t, c, "UnmarshalNext() null")
+}
@@ -0,0 +1,178 @@
+package zero
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/toby1991/null/nulldriver"
+	"github.com/toby1991/null/nulljson"
+)
+
+// Variable is a generic nullable value of type T.
+// JSON marshals to the zero value of T if null.
+// Considered null to SQL if it equals the zero value of T.
+//
+// T must be comparable so this type can tell the zero value apart from a set
+// value, the same way the other types in this package do.
+type Variable[T comparable] struct {
+	val   T
+	Valid bool
+}
+
+// NewVariable creates a new Variable[T].
+func NewVariable[T comparable](v T, valid bool) Variable[T] {
+	return Variable[T]{val: v, Valid: valid}
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the zero value of T.
+//
+// There is no separate Value() T accessor: that name is reserved for the
+// driver.Valuer implementation below, matching every other type in this
+// package.
+func (v Variable[T]) ValueOrZero() T {
+	if !v.Valid {
+		var zero T
+		return zero
+	}
+	return v.val
+}
+
+// Reset clears the value back to invalid and the zero value of T.
+func (v *Variable[T]) Reset() {
+	var zero T
+	v.val = zero
+	v.Valid = false
+}
+
+// IsNil returns true if this Variable is null.
+func (v Variable[T]) IsNil() bool {
+	return !v.Valid
+}
+
+// NotNil returns true if this Variable is not null.
+func (v Variable[T]) NotNil() bool {
+	return v.Valid
+}
+
+// SetValid changes this Variable's value and also sets it to be non-null.
+func (v *Variable[T]) SetValid(n T) {
+	v.val = n
+	v.Valid = true
+}
+
+// IsZero returns true for null or zero-valued Variables, for future omitempty support (Go 1.4?)
+func (v Variable[T]) IsZero() bool {
+	var zero T
+	return !v.Valid || v.val == zero
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the zero value of T if this Variable is null.
+func (v Variable[T]) MarshalJSON() ([]byte, error) {
+	if !v.Valid {
+		var zero T
+		return json.Marshal(zero)
+	}
+	return json.Marshal(v.val)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It will be considered null if the input decodes to the zero value of T.
+func (v *Variable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullBytes) {
+		v.Valid = false
+		return nil
+	}
+	if err := json.Unmarshal(data, &v.val); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal JSON: %w", err)
+	}
+	var zero T
+	v.Valid = v.val != zero
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the zero value of T if this Variable is null.
+// If T implements encoding.TextMarshaler, that is used; otherwise the value
+// is formatted with fmt.
+func (v Variable[T]) MarshalText() ([]byte, error) {
+	val := v.val
+	if !v.Valid {
+		var zero T
+		val = zero
+	}
+	if m, ok := any(val).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	return []byte(fmt.Sprint(val)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Variable if the input is a blank, or the zero
+// value of T.
+// If T implements encoding.TextUnmarshaler, that is used; otherwise the value
+// is scanned with fmt.
+func (v *Variable[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 || string(text) == "null" {
+		v.Valid = false
+		return nil
+	}
+	if u, ok := any(&v.val).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+		}
+	} else if _, err := fmt.Sscan(string(text), &v.val); err != nil {
+		return fmt.Errorf("zero: couldn't unmarshal text: %w", err)
+	}
+	var zero T
+	v.Valid = v.val != zero
+	return nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (v *Variable[T]) Scan(value interface{}) error {
+	if value == nil {
+		var zero T
+		v.val = zero
+		v.Valid = false
+		return nil
+	}
+	if n, ok := value.(T); ok {
+		v.val = n
+	} else {
+		// The driver's concrete type (e.g. int64) doesn't match T exactly, but
+		// may still be convertible to it (e.g. time.Duration is backed by int64).
+		rv := reflect.ValueOf(value)
+		rt := reflect.TypeOf(v.val)
+		if !rv.Type().ConvertibleTo(rt) {
+			return fmt.Errorf("zero: cannot scan type %T into zero.Variable[%T]", value, v.val)
+		}
+		v.val = rv.Convert(rt).Interface().(T)
+	}
+	var zero T
+	v.Valid = v.val != zero
+	return nil
+}
+
+// Value implements the driver.Valuer interface. v.val is converted the way
+// database/sql/driver.DefaultParameterConverter would, since T's underlying
+// type (e.g. time.Duration, whose kind is int64) won't generally already be
+// one of the types driver.Value allows.
+func (v Variable[T]) Value() (driver.Value, error) {
+	if !v.Valid {
+		var zero T
+		return nulldriver.Value(zero)
+	}
+	return nulldriver.Value(v.val)
+}
+
+// UnmarshalNext implements a streaming variant of UnmarshalJSON for use with
+// json.Decoder, so large NDJSON-style input doesn't need to be buffered
+// field-by-field before each value can be decoded.
+func (v *Variable[T]) UnmarshalNext(dec *json.Decoder) error {
+	return nulljson.UnmarshalNext(dec, v)
+}